@@ -1,6 +1,10 @@
 package assert
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+)
 
 // #####################################################################################################################
 // INTERFACE
@@ -14,6 +18,145 @@ type assertInterface[T any] interface {
 	// Panics, if check is nil.
 	addCheck(check func(v T) error)
 
+	// addCheckCtx
+	//
+	// Registers custom validation check, that takes the context of the result method.
+	//
+	// Panics, if check is nil.
+	addCheckCtx(check func(ctx context.Context, v T) error)
+
+	// addRule
+	//
+	// Registers validation check of the built-in rule with its code, parameters and custom message.
+	//
+	// Panics, if check is nil.
+	addRule(code string, params map[string]any, customErrMsg []string, check func(v T) error)
+
+	// addRuleCtx
+	//
+	// Works same as addRule, but the check takes the context of the result method.
+	//
+	// Panics, if check is nil.
+	addRuleCtx(code string, params map[string]any, customErrMsg []string, check func(ctx context.Context, v T) error)
+
+	// negateNext
+	//
+	// Makes the next registered check negated. Repeated calls cancel each other.
+	negateNext()
+
+	// clone
+	//
+	// Returns the independent copy of the assert.
+	clone() *assert[T]
+
+	// addRules
+	//
+	// Appends rules of the given assert -- see Use.
+	//
+	// Panics, if the negation of the next check is pending.
+	addRules(src *assert[T])
+
+	// wrapLastCheck
+	//
+	// Replaces the last registered check with the result of the wrapper.
+	//
+	// Panics, if no checks registered or wrapper is nil.
+	wrapLastCheck(wrapper func(check func(ctx context.Context, v T) error) func(ctx context.Context, v T) error)
+
+	// setLastTimeout
+	//
+	// Sets the timeout of the last registered check.
+	//
+	// Panics, if no checks registered.
+	setLastTimeout(timeout time.Duration)
+
+	// setLastBail
+	//
+	// Marks the last registered check as the bail-out point of CheckAll-family methods.
+	//
+	// Panics, if no checks registered.
+	setLastBail()
+
+	// setLastSeverity
+	//
+	// Sets the severity of the last registered check.
+	//
+	// Panics, if no checks registered.
+	setLastSeverity(severity Severity)
+
+	// setLastDeps
+	//
+	// Marks the last registered check as dependent on preceding checks with the given codes (any, if empty).
+	//
+	// Panics, if no checks registered.
+	setLastDeps(codes []string)
+
+	// setLastGroups
+	//
+	// Adds the last registered check to the given groups.
+	//
+	// Panics, if no checks registered.
+	setLastGroups(groups []string)
+
+	// setLastName
+	//
+	// Sets the name of the last registered check -- the name of the alias of the built-in rule, e.g. "Word".
+	//
+	// Panics, if no checks registered.
+	setLastName(name string)
+
+	// setLastCheckAll
+	//
+	// Sets the check of the last registered check for CheckAll-family methods, that returns all errors as subErrs.
+	// Ignored, if the last check is negated.
+	//
+	// Panics, if no checks registered or check is nil.
+	setLastCheckAll(check func(ctx context.Context, v T) error)
+
+	// wrapLastErr
+	//
+	// Adds the wrapper of errors of the last registered check.
+	//
+	// Panics, if no checks registered or wrapper is nil.
+	wrapLastErr(wrapper func(err error) error)
+
+	// wrapErr
+	//
+	// Adds the wrapper of errors of all checks.
+	//
+	// Panics, if wrapper is nil.
+	wrapErr(wrapper func(err error) error)
+
+	// setName
+	//
+	// Sets the name of the checked value.
+	setName(name string)
+
+	// setSensitive
+	//
+	// Marks the checked value as sensitive.
+	setSensitive()
+
+	// setFormatter
+	//
+	// Sets the formatter of values in messages. Nil means the global formatter.
+	setFormatter(f ValueFormatter)
+
+	// setFailureHandler
+	//
+	// Sets the handler of failures of Must-family methods. Nil means the global handler.
+	setFailureHandler(h FailureHandler)
+
+	// setWorkers
+	//
+	// Sets the number of workers to run checks in CheckAll-family methods. 1 means sequential run.
+	setWorkers(workers int)
+
+	// setMaxErrors
+	//
+	// Sets the max number of errors of CheckAll-family methods. 0 means no limit.
+	setMaxErrors(n int)
+
 	// Check
 	//
 	// Runs registered validation checks one by one against the given value and returns an error from assert first failed check.
@@ -26,16 +169,77 @@ type assertInterface[T any] interface {
 	// Returns empty slice, if all checks pass.
 	CheckAll(v T) []error
 
+	// CheckAllErr
+	//
+	// Works same as CheckAll, but returns errors as ValidationErrors.
+	// Returns nil, if all checks pass.
+	CheckAllErr(v T) error
+
+	// CheckCtx
+	//
+	// Works same as Check, but passes the context to the checks and stops on its cancellation.
+	CheckCtx(ctx context.Context, v T, customErrMsg ...string) error
+
+	// CheckAllCtx
+	//
+	// Works same as CheckAll, but passes the context to the checks and stops on its cancellation.
+	CheckAllCtx(ctx context.Context, v T) []error
+
+	// CheckAllErrCtx
+	//
+	// Works same as CheckAllErr, but passes the context to the checks and stops on its cancellation.
+	CheckAllErrCtx(ctx context.Context, v T) error
+
+	// Validate
+	//
+	// Runs all registered validation checks including warnings and returns the Result.
+	Validate(v T) *Result
+
+	// ValidateCtx
+	//
+	// Works same as Validate, but passes the context to the checks and stops on its cancellation.
+	ValidateCtx(ctx context.Context, v T) *Result
+
+	// Lint
+	//
+	// Analyzes registered rules and returns issues of the chain -- contradictions, empty sets, etc.
+	Lint() []LintIssue
+
+	// Describe
+	//
+	// Returns the description of registered rules.
+	Describe() *Description
+
+	// Explain
+	//
+	// Runs each registered rule against the given value and returns results of all rules.
+	Explain(v T) *Explanation
+
+	// ExplainCtx
+	//
+	// Works same as Explain, but passes the context to the checks and stops on its cancellation.
+	ExplainCtx(ctx context.Context, v T) *Explanation
+
 	// Must
 	//
-	// Calls Check and panics with the error if validation fails.
+	// Calls Check and panics with the error wrapped into MustError if validation fails.
 	Must(v T, customErrMsg ...string)
 
 	// MustAll
 	//
-	// Calls CheckAll and panics with the error slice if validation fails.
+	// Calls CheckAll and panics with the ValidationErrors wrapped into MustError if validation fails.
 	MustAll(v T)
 
+	// MustCtx
+	//
+	// Works same as Must, but passes the context to the checks and stops on its cancellation.
+	MustCtx(ctx context.Context, v T, customErrMsg ...string)
+
+	// MustAllCtx
+	//
+	// Works same as MustAll, but passes the context to the checks and stops on its cancellation.
+	MustAllCtx(ctx context.Context, v T)
+
 	// MustGet
 	//
 	// Works same as Must, but returns the value, if no panic occurred.
@@ -51,13 +255,70 @@ type assertInterface[T any] interface {
 // ASSERT
 // #####################################################################################################################
 
+// rule
+//
+// Registered validation check with its settings.
+type rule[T any] struct {
+	code       string
+	name       string
+	params     map[string]any
+	customMsg  []string
+	check      func(ctx context.Context, v T) error
+	checkAll   func(ctx context.Context, v T) error
+	timeout    time.Duration
+	errWrapper func(err error) error
+	severity   Severity
+	bail       bool
+	dependent  bool
+	deps       []string
+	groups     []string
+	not        bool
+}
+
+// copy
+//
+// Returns the independent copy of the rule -- adjusting the copy (e.g. Timeout) does not affect the original.
+func (r *rule[T]) copy() *rule[T] {
+	cr := *r
+	cr.deps = append([]string(nil), r.deps...)
+	cr.groups = append([]string(nil), r.groups...)
+	return &cr
+}
+
+// isSkipped
+//
+// Returns true, if the rule depends on any of the failed rules -- see DependsOn.
+// The failed rules are given by their codes and names of aliases (e.g. "NotEmpty" for Str().NotEmpty()).
+func (r *rule[T]) isSkipped(failed []string) bool {
+	if !r.dependent || len(failed) == 0 {
+		return false
+	}
+	if len(r.deps) == 0 {
+		return true
+	}
+	for _, code := range failed {
+		if code != "" && inSlice(r.deps, code) {
+			return true
+		}
+	}
+	return false
+}
+
 type assert[T any] struct {
-	checks []func(v T) error
+	rules      []*rule[T]
+	name       string
+	sensitive  bool
+	formatter  ValueFormatter
+	errWrapper func(err error) error
+	onFailure  FailureHandler
+	workers    int
+	maxErrors  int
+	negate     bool
 }
 
 func newAssert[T any]() *assert[T] {
 	return &assert[T]{
-		checks: make([]func(v T) error, 0, 1),
+		rules: make([]*rule[T], 0, 1),
 	}
 }
 
@@ -71,7 +332,341 @@ func (a *assert[T]) addCheck(check func(v T) error) {
 		panic(fmt.Errorf("%T.addCheck expects not nil check", a))
 	}
 
-	a.checks = append(a.checks, check)
+	a.addRule(CodeCustom, nil, nil, check)
+}
+
+// addCheckCtx
+//
+// Registers custom validation check, that takes the context of the result method.
+//
+// Panics, if check is nil.
+func (a *assert[T]) addCheckCtx(check func(ctx context.Context, v T) error) {
+	if check == nil {
+		panic(fmt.Errorf("%T.addCheckCtx expects not nil check", a))
+	}
+
+	a.addRuleCtx(CodeCustom, nil, nil, check)
+}
+
+// addRule
+//
+// Registers validation check of the built-in rule with its code, parameters and custom message.
+//
+// Panics, if check is nil.
+func (a *assert[T]) addRule(code string, params map[string]any, customErrMsg []string, check func(v T) error) {
+	if check == nil {
+		panic(fmt.Errorf("%T.addRule expects not nil check", a))
+	}
+
+	a.addRuleCtx(code, params, customErrMsg, func(_ context.Context, v T) error { return check(v) })
+}
+
+// addRuleCtx
+//
+// Works same as addRule, but the check takes the context of the result method.
+//
+// Panics, if check is nil.
+func (a *assert[T]) addRuleCtx(
+	code string,
+	params map[string]any,
+	customErrMsg []string,
+	check func(ctx context.Context, v T) error,
+) {
+	if check == nil {
+		panic(fmt.Errorf("%T.addRuleCtx expects not nil check", a))
+	}
+
+	r := &rule[T]{code: code, params: params, customMsg: customErrMsg, check: check}
+	if a.negate {
+		r.check = r.negated(check)
+		r.not = true
+		a.negate = false
+	}
+	a.rules = append(a.rules, r)
+}
+
+// negateNext
+//
+// Makes the next registered check negated. Repeated calls cancel each other.
+func (a *assert[T]) negateNext() {
+	a.negate = !a.negate
+}
+
+// clone
+//
+// Returns the independent copy of the assert -- rules are copied, so adjusting the last rule of the copy
+// (e.g. Timeout) does not affect the original.
+func (a *assert[T]) clone() *assert[T] {
+	c := *a
+	c.rules = make([]*rule[T], 0, len(a.rules))
+	for _, r := range a.rules {
+		c.rules = append(c.rules, r.copy())
+	}
+	return &c
+}
+
+// addRules
+//
+// Appends copies of rules of the given assert -- see Use. The given assert is not changed.
+//
+// Errors of the appended rules are wrapped with the wrapper of errors of the given assert, if any.
+// The assert becomes sensitive, if the given one is.
+//
+// Panics, if the negation of the next check is pending.
+func (a *assert[T]) addRules(src *assert[T]) {
+	if a.negate {
+		panic(fmt.Errorf("%T.addRules expects no pending negation", a))
+	}
+
+	for _, r := range src.rules {
+		cr := r.copy()
+		cr.errWrapper = joinErrWrappers(r.errWrapper, src.errWrapper)
+		a.rules = append(a.rules, cr)
+	}
+	a.sensitive = a.sensitive || src.sensitive
+}
+
+// wrapLastCheck
+//
+// Replaces the last registered check with the result of the wrapper.
+// The check for CheckAll-family methods is dropped, so the wrapped check is used there too -- see setLastCheckAll.
+//
+// Panics, if no checks registered or wrapper is nil.
+func (a *assert[T]) wrapLastCheck(
+	wrapper func(check func(ctx context.Context, v T) error) func(ctx context.Context, v T) error,
+) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.wrapLastCheck expects at least one registered check", a))
+	}
+	if wrapper == nil {
+		panic(fmt.Errorf("%T.wrapLastCheck expects not nil wrapper", a))
+	}
+
+	r := a.rules[len(a.rules)-1]
+	r.check = wrapper(r.check)
+	r.checkAll = nil
+}
+
+// setLastTimeout
+//
+// Sets the timeout of the last registered check.
+//
+// Panics, if no checks registered.
+func (a *assert[T]) setLastTimeout(timeout time.Duration) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastTimeout expects at least one registered check", a))
+	}
+
+	a.rules[len(a.rules)-1].timeout = timeout
+}
+
+// setLastBail
+//
+// Marks the last registered check as the bail-out point of CheckAll-family methods.
+//
+// Panics, if no checks registered.
+func (a *assert[T]) setLastBail() {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastBail expects at least one registered check", a))
+	}
+
+	a.rules[len(a.rules)-1].bail = true
+}
+
+// setLastSeverity
+//
+// Sets the severity of the last registered check.
+//
+// Panics, if no checks registered.
+func (a *assert[T]) setLastSeverity(severity Severity) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastSeverity expects at least one registered check", a))
+	}
+
+	a.rules[len(a.rules)-1].severity = severity
+}
+
+// setLastDeps
+//
+// Marks the last registered check as dependent on preceding checks with the given codes (any, if empty).
+//
+// Panics, if no checks registered.
+func (a *assert[T]) setLastDeps(codes []string) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastDeps expects at least one registered check", a))
+	}
+
+	r := a.rules[len(a.rules)-1]
+	r.dependent = true
+	r.deps = append(r.deps, codes...)
+}
+
+// setLastGroups
+//
+// Adds the last registered check to the given groups.
+//
+// Panics, if no checks registered.
+func (a *assert[T]) setLastGroups(groups []string) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastGroups expects at least one registered check", a))
+	}
+
+	r := a.rules[len(a.rules)-1]
+	r.groups = append(r.groups, groups...)
+}
+
+// setLastName
+//
+// Sets the name of the last registered check -- the name of the alias of the built-in rule, e.g. "Word".
+//
+// Panics, if no checks registered.
+func (a *assert[T]) setLastName(name string) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastName expects at least one registered check", a))
+	}
+
+	a.rules[len(a.rules)-1].name = name
+}
+
+// setLastCheckAll
+//
+// Sets the check of the last registered check for CheckAll-family methods, that returns all errors as subErrs --
+// e.g. errors of all failed rules of sub-chains of conditional rules (see When).
+// Ignored, if the last check is negated -- the negation has a single error.
+//
+// Panics, if no checks registered or check is nil.
+func (a *assert[T]) setLastCheckAll(check func(ctx context.Context, v T) error) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.setLastCheckAll expects at least one registered check", a))
+	}
+	if check == nil {
+		panic(fmt.Errorf("%T.setLastCheckAll expects not nil check", a))
+	}
+
+	r := a.rules[len(a.rules)-1]
+	if !r.not {
+		r.checkAll = check
+	}
+}
+
+// wrapLastErr
+//
+// Adds the wrapper of errors of the last registered check.
+//
+// Panics, if no checks registered or wrapper is nil.
+func (a *assert[T]) wrapLastErr(wrapper func(err error) error) {
+	if len(a.rules) == 0 {
+		panic(fmt.Errorf("%T.wrapLastErr expects at least one registered check", a))
+	}
+	if wrapper == nil {
+		panic(fmt.Errorf("%T.wrapLastErr expects not nil wrapper", a))
+	}
+
+	r := a.rules[len(a.rules)-1]
+	r.errWrapper = joinErrWrappers(r.errWrapper, wrapper)
+}
+
+// wrapErr
+//
+// Adds the wrapper of errors of all checks.
+//
+// Panics, if wrapper is nil.
+func (a *assert[T]) wrapErr(wrapper func(err error) error) {
+	if wrapper == nil {
+		panic(fmt.Errorf("%T.wrapErr expects not nil wrapper", a))
+	}
+
+	a.errWrapper = joinErrWrappers(a.errWrapper, wrapper)
+}
+
+// setName
+//
+// Sets the name of the checked value.
+func (a *assert[T]) setName(name string) {
+	a.name = name
+}
+
+// setSensitive
+//
+// Marks the checked value as sensitive.
+func (a *assert[T]) setSensitive() {
+	a.sensitive = true
+}
+
+// setFormatter
+//
+// Sets the formatter of values in messages. Nil means the global formatter.
+func (a *assert[T]) setFormatter(f ValueFormatter) {
+	a.formatter = f
+}
+
+// setFailureHandler
+//
+// Sets the handler of failures of Must-family methods. Nil means the global handler.
+func (a *assert[T]) setFailureHandler(h FailureHandler) {
+	a.onFailure = h
+}
+
+// fail
+//
+// Handles the failure of Must-family methods.
+func (a *assert[T]) fail(err error) {
+	h := a.onFailure
+	if h == nil {
+		h = globalFailureHandler()
+	}
+	h(newMustError(err))
+}
+
+// setWorkers
+//
+// Sets the number of workers to run checks in CheckAll-family methods. 1 means sequential run.
+func (a *assert[T]) setWorkers(workers int) {
+	a.workers = workers
+}
+
+// setMaxErrors
+//
+// Sets the max number of errors of CheckAll-family methods. 0 means no limit.
+func (a *assert[T]) setMaxErrors(n int) {
+	a.maxErrors = n
+}
+
+// checkErr
+//
+// Adjusts the error of a failed check according to the chain settings.
+func (a *assert[T]) checkErr(err error) error {
+	if a.formatter != nil {
+		err = errFormatted(err, a.formatter)
+	}
+	if a.sensitive {
+		err = errRedacted(err)
+	}
+	return errWithPath(err, a.name)
+}
+
+// wrappedErr
+//
+// Wraps the final error of the failed rule with wrappers of the rule and of the chain.
+func (a *assert[T]) wrappedErr(r *rule[T], err error) error {
+	if r.errWrapper != nil {
+		err = r.errWrapper(err)
+	}
+	if a.errWrapper != nil {
+		err = a.errWrapper(err)
+	}
+	return err
+}
+
+// msgArgs
+//
+// Returns the arguments of custom messages not related to any rule.
+func (a *assert[T]) msgArgs(v T) MessageArgs {
+	return MessageArgs{
+		Path:      a.name,
+		Value:     ternary[any](a.sensitive, redactVal(v), v),
+		formatter: a.formatter,
+	}
 }
 
 // Check
@@ -79,15 +674,7 @@ func (a *assert[T]) addCheck(check func(v T) error) {
 // Runs registered validation checks one by one against the given value and returns an error from a first failed check.
 // Returns nil, if all checks pass.
 func (a *assert[T]) Check(v T, customErrMsg ...string) error {
-	for _, check := range a.checks {
-		if err := check(v); err != nil {
-			if customErr := mkCustomErr(customErrMsg); customErr != nil {
-				return customErr
-			}
-			return err
-		}
-	}
-	return nil
+	return a.CheckCtx(context.Background(), v, customErrMsg...)
 }
 
 // CheckAll
@@ -95,33 +682,244 @@ func (a *assert[T]) Check(v T, customErrMsg ...string) error {
 // Runs registered validation checks one by one against the given value and returns errors from all failed checks.
 // Returns empty slice, if all checks pass.
 func (a *assert[T]) CheckAll(v T) []error {
-	errs := make([]error, 0, len(a.checks))
-	for _, check := range a.checks {
-		err := check(v)
-		if err != nil {
-			errs = append(errs, err)
+	return a.CheckAllCtx(context.Background(), v)
+}
+
+// CheckAllErr
+//
+// Works same as CheckAll, but returns errors as ValidationErrors.
+// Returns nil, if all checks pass.
+func (a *assert[T]) CheckAllErr(v T) error {
+	return a.CheckAllErrCtx(context.Background(), v)
+}
+
+// CheckCtx
+//
+// Works same as Check, but passes the context to the checks (see CustomCtx) and stops on its cancellation --
+// the error of the context is returned as is.
+//
+// Runs only rules of groups selected in the context, if any -- see WithGroups.
+func (a *assert[T]) CheckCtx(ctx context.Context, v T, customErrMsg ...string) error {
+	selected := selectedGroups(ctx)
+	for _, r := range a.rules {
+		if r.severity == SeverityWarning || !r.isSelected(selected) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := r.run(ctx, v); err != nil {
+			if isCtxErr(ctx, err) {
+				return err
+			}
+			err = a.checkErr(err)
+			if customErr := mkCustomErr(err, a.msgArgs(v), customErrMsg); customErr != nil {
+				err = customErr
+			}
+			return a.wrappedErr(r, err)
 		}
 	}
+	return nil
+}
+
+// CheckAllCtx
+//
+// Works same as CheckAll, but passes the context to the checks (see CustomCtx) and stops on its cancellation --
+// the error of the context is appended to the errors as is.
+//
+// Runs only rules of groups selected in the context, if any -- see WithGroups.
+func (a *assert[T]) CheckAllCtx(ctx context.Context, v T) []error {
+	errs, _ := a.checkAll(ctx, v, false)
 	return errs
 }
 
+// checkAll
+//
+// Runs rules for CheckAll-family methods and Validate -- sequentially or in parallel (see Parallel).
+// Warning rules are run only if "warnings" is true.
+func (a *assert[T]) checkAll(ctx context.Context, v T, warnings bool) (errs []error, warns []error) {
+	if a.workers > 1 && len(a.rules) > 1 {
+		return a.checkAllParallel(ctx, v, warnings)
+	}
+
+	c := a.newErrCollector(ctx, warnings)
+	for i, r := range a.rules {
+		if c.stopped {
+			break
+		}
+		if c.skips(r) {
+			continue
+		}
+		err := ctx.Err()
+		if err == nil {
+			err = r.runAll(ctx, v)
+		}
+		c.collect(i, err)
+	}
+	return c.errs, c.warns
+}
+
+// errCollector
+//
+// Collects errors and warnings of rules of CheckAll-family methods in the order of rules with respect to dependencies
+// of rules, bail-out points and the max number of errors -- errors of sub-chains (subErrs) are collected
+// as separate errors of the rule.
+// Warning rules are skipped, if "warnings" is false, as well as rules of not selected groups.
+//
+// Stops on cancellation of the context -- the error of the context is appended to errors as is.
+type errCollector[T any] struct {
+	a        *assert[T]
+	ctx      context.Context
+	warnings bool
+	selected []string
+	errs     []error
+	warns    []error
+	failed   []string
+	stopped  bool
+}
+
+func (a *assert[T]) newErrCollector(ctx context.Context, warnings bool) *errCollector[T] {
+	return &errCollector[T]{
+		a:        a,
+		ctx:      ctx,
+		warnings: warnings,
+		selected: selectedGroups(ctx),
+		errs:     make([]error, 0, len(a.rules)),
+		failed:   make([]string, 0, len(a.rules)),
+	}
+}
+
+// skips
+//
+// Returns true, if the rule is not run -- it is a warning (unless collected), it is not in selected groups,
+// or it depends on failed rules collected so far.
+func (c *errCollector[T]) skips(r *rule[T]) bool {
+	return (r.severity == SeverityWarning && !c.warnings) || !r.isSelected(c.selected) || r.isSkipped(c.failed)
+}
+
+// collect
+//
+// Collects the error of the rule by its index. Marks the collector stopped, if no more errors are expected.
+func (c *errCollector[T]) collect(i int, err error) {
+	if err == nil {
+		return
+	}
+	if isCtxErr(c.ctx, err) {
+		c.errs = append(c.errs, err)
+		c.stopped = true
+		return
+	}
+
+	r := c.a.rules[i]
+	ruleErrs := []error{err}
+	if sub, ok := err.(subErrs); ok {
+		ruleErrs = sub
+	}
+	if r.name != "" {
+		c.failed = append(c.failed, r.name)
+	}
+	for _, err := range ruleErrs {
+		c.failed = append(c.failed, errRule(err))
+		err = c.a.wrappedErr(r, c.a.checkErr(err))
+		if r.severity == SeverityWarning {
+			c.warns = append(c.warns, err)
+			continue
+		}
+		c.errs = append(c.errs, err)
+		if c.a.maxErrors > 0 && len(c.errs) >= c.a.maxErrors {
+			c.stopped = true
+			return
+		}
+	}
+	c.stopped = r.bail && r.severity != SeverityWarning
+}
+
+// subErrs
+//
+// Errors of all failed rules of a sub-chain -- returned by checks for CheckAll-family methods (see setLastCheckAll)
+// and collected as separate errors of the rule.
+type subErrs []error
+
+func (es subErrs) Error() string {
+	return ValidationErrors(es).Error()
+}
+
+// CheckAllErrCtx
+//
+// Works same as CheckAllCtx, but returns errors as ValidationErrors.
+// Returns nil, if all checks pass.
+func (a *assert[T]) CheckAllErrCtx(ctx context.Context, v T) error {
+	if errs := a.CheckAllCtx(ctx, v); len(errs) > 0 {
+		return ValidationErrors(errs)
+	}
+	return nil
+}
+
+// Validate
+//
+// Runs all registered validation checks including warnings (see Warn) and returns the Result,
+// that separates errors and warnings.
+//
+// Works same as CheckAll for errors -- including Bail, DependsOn, MaxErrors and Parallel.
+func (a *assert[T]) Validate(v T) *Result {
+	return a.ValidateCtx(context.Background(), v)
+}
+
+// ValidateCtx
+//
+// Works same as Validate, but passes the context to the checks (see CustomCtx) and stops on its cancellation --
+// the error of the context is appended to errors as is.
+//
+// Runs only rules of groups selected in the context, if any -- see WithGroups.
+func (a *assert[T]) ValidateCtx(ctx context.Context, v T) *Result {
+	errs, warns := a.checkAll(ctx, v, true)
+	res := &Result{}
+	if len(errs) > 0 {
+		res.Errors = errs
+	}
+	if len(warns) > 0 {
+		res.Warnings = warns
+	}
+	return res
+}
+
 // Must
 //
-// Calls Check and panics with the error if validation fails.
+// Calls Check and panics with the error wrapped into MustError if validation fails.
+//
+// The failure policy can be changed -- see FailureHandler.
 func (a *assert[T]) Must(v T, customErrMsg ...string) {
-	err := a.Check(v, customErrMsg...)
-	if err != nil {
-		panic(err)
+	if err := a.Check(v, customErrMsg...); err != nil {
+		a.fail(err)
 	}
 }
 
 // MustAll
 //
-// Calls CheckAll and panics with the error slice if validation fails.
+// Calls CheckAll and panics with the ValidationErrors wrapped into MustError if validation fails.
+//
+// The failure policy can be changed -- see FailureHandler.
 func (a *assert[T]) MustAll(v T) {
-	errs := a.CheckAll(v)
-	if len(errs) > 0 {
-		panic(errs)
+	if err := a.CheckAllErr(v); err != nil {
+		a.fail(err)
+	}
+}
+
+// MustCtx
+//
+// Works same as Must, but calls CheckCtx.
+func (a *assert[T]) MustCtx(ctx context.Context, v T, customErrMsg ...string) {
+	if err := a.CheckCtx(ctx, v, customErrMsg...); err != nil {
+		a.fail(err)
+	}
+}
+
+// MustAllCtx
+//
+// Works same as MustAll, but calls CheckAllErrCtx.
+func (a *assert[T]) MustAllCtx(ctx context.Context, v T) {
+	if err := a.CheckAllErrCtx(ctx, v); err != nil {
+		a.fail(err)
 	}
 }
 
@@ -14,6 +14,7 @@ func Test_Assert(t *testing.T) {
 		a := newAssert[int]()
 		tAssert.NoError(t, a.Check(42))
 		tAssert.Len(t, a.CheckAll(42), 0)
+		tAssert.Nil(t, a.CheckAllErr(42))
 		tAssert.NotPanics(t, func() { a.Must(42) })
 		tAssert.NotPanics(t, func() { a.MustAll(42) })
 	})
@@ -23,6 +24,7 @@ func Test_Assert(t *testing.T) {
 		a.addCheck(func(v int) error { return nil })
 		tAssert.NoError(t, a.Check(42))
 		tAssert.Len(t, a.CheckAll(42), 0)
+		tAssert.Nil(t, a.CheckAllErr(42))
 		tAssert.NotPanics(t, func() { a.Must(42) })
 		tAssert.NotPanics(t, func() { a.MustAll(42) })
 	})
@@ -34,6 +36,7 @@ func Test_Assert(t *testing.T) {
 		a.addCheck(func(v string) error { return nil })
 		tAssert.NoError(t, a.Check("hello"))
 		tAssert.Len(t, a.CheckAll("hello"), 0)
+		tAssert.Nil(t, a.CheckAllErr("hello"))
 		tAssert.NotPanics(t, func() { a.Must("hello") })
 		tAssert.NotPanics(t, func() { a.MustAll("hello") })
 	})
@@ -41,8 +44,13 @@ func Test_Assert(t *testing.T) {
 	// Error
 	// --------------------------------
 
-	tErrMustAll := func(fnT func(), fnA func(errs []error)) {
-		defer func() { fnA(recover().([]error)) }()
+	tErrMust := func(fnT func(), fnA func(err error)) {
+		defer func() { fnA(recover().(*MustError).Err) }()
+		fnT()
+	}
+
+	tErrMustAll := func(fnT func(), fnA func(errs ValidationErrors)) {
+		defer func() { fnA(recover().(*MustError).Err.(ValidationErrors)) }()
 		fnT()
 	}
 
@@ -58,10 +66,12 @@ func Test_Assert(t *testing.T) {
 		errs := a.CheckAll(42)
 		tAssert.Len(t, errs, 1)
 		tAssert.Equal(t, rErr, errs[0])
+		// check all err
+		tAssert.Equal(t, ValidationErrors{rErr}, a.CheckAllErr(42))
 		// must
-		tAssert.PanicsWithValue(t, rErr, func() { a.Must(42) })
+		tErrMust(func() { a.Must(42) }, func(err error) { tAssert.Equal(t, rErr, err) })
 		// must all
-		tErrMustAll(func() { a.MustAll(42) }, func(errs []error) {
+		tErrMustAll(func() { a.MustAll(42) }, func(errs ValidationErrors) {
 			tAssert.Len(t, errs, 1)
 			tAssert.Equal(t, rErr, errs[0])
 		})
@@ -88,10 +98,12 @@ func Test_Assert(t *testing.T) {
 		tAssert.Equal(t, rErr1, errs[0])
 		tAssert.Equal(t, rErr2, errs[1])
 		tAssert.Equal(t, rErr3, errs[2])
+		// check all err
+		tAssert.Equal(t, ValidationErrors{rErr1, rErr2, rErr3}, a.CheckAllErr(true))
 		// must
-		tAssert.PanicsWithValue(t, rErr1, func() { a.Must(true) })
+		tErrMust(func() { a.Must(true) }, func(err error) { tAssert.Equal(t, rErr1, err) })
 		// must all
-		tErrMustAll(func() { a.MustAll(true) }, func(errs []error) {
+		tErrMustAll(func() { a.MustAll(true) }, func(errs ValidationErrors) {
 			tAssert.Len(t, errs, 3)
 			tAssert.Equal(t, rErr1, errs[0])
 			tAssert.Equal(t, rErr2, errs[1])
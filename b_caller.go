@@ -0,0 +1,89 @@
+package assert
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Location
+//
+// Location of a call in the source code.
+type Location struct {
+	// Function -- full name of the function, e.g. "github.com/user/app/user.(*Service).Deactivate".
+	Function string `json:"function"`
+	// File -- full path of the file.
+	File string `json:"file"`
+	// Line -- line in the file.
+	Line int `json:"line"`
+}
+
+// String
+//
+// Returns the location in "file:line" format.
+func (l Location) String() string {
+	return l.File + ":" + strconv.Itoa(l.Line)
+}
+
+// callerMaxDepth -- max number of frames to look through for the caller and to capture into the stack.
+const callerMaxDepth = 64
+
+// callerPkgPrefix -- prefix of functions of this package -- their frames are skipped.
+var callerPkgPrefix = reflect.TypeOf(assert[int]{}).PkgPath() + "."
+
+var stackCapture = struct {
+	mu      sync.RWMutex
+	enabled bool
+}{}
+
+// SetStackCapture
+//
+// Enables or disables capturing of the full stack of calls for failures of Must-family methods -- see MustError.
+//
+// Disabled by default -- only the location of the caller is captured.
+// Useful for debugging builds.
+func SetStackCapture(enabled bool) {
+	stackCapture.mu.Lock()
+	defer stackCapture.mu.Unlock()
+	stackCapture.enabled = enabled
+}
+
+// callers
+//
+// Returns the location of the first caller outside the package and the stack starting from it, if enabled.
+//
+// Tests of the package are considered as callers outside it.
+func callers() (caller Location, stack []Location) {
+	pcs := make([]uintptr, callerMaxDepth)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	stackCapture.mu.RLock()
+	capture := stackCapture.enabled
+	stackCapture.mu.RUnlock()
+
+	found := false
+	for {
+		frame, more := frames.Next()
+		if found || !isLibFrame(frame) {
+			l := Location{Function: frame.Function, File: frame.File, Line: frame.Line}
+			if !found {
+				caller, found = l, true
+			}
+			if !capture {
+				break
+			}
+			stack = append(stack, l)
+		}
+		if !more {
+			break
+		}
+	}
+	return caller, stack
+}
+
+func isLibFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, callerPkgPrefix) && !strings.HasSuffix(frame.File, "_test.go")
+}
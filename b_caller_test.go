@@ -0,0 +1,106 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_MustError(t *testing.T) {
+	t.Cleanup(func() { SetStackCapture(false) })
+
+	fnRecover := func(fn func()) (me *MustError) {
+		defer func() {
+			me = recover().(*MustError)
+		}()
+		fn()
+		return nil
+	}
+
+	fnLine := func() int {
+		_, _, line, _ := runtime.Caller(1)
+		return line
+	}
+
+	t.Run("caller of methods", func(t *testing.T) {
+		var line int
+		cases := map[string]func(){
+			"Must": func() {
+				line = fnLine() + 1
+				Str().LenMax(2).Must("hello")
+			},
+			"MustGet": func() {
+				line = fnLine() + 1
+				Str().LenMax(2).MustGet("hello")
+			},
+			"MustAll": func() {
+				line = fnLine() + 1
+				Str().LenMax(2).MustAll("hello")
+			},
+			"MustAllGet": func() {
+				line = fnLine() + 1
+				Str().LenMax(2).MustAllGet("hello")
+			},
+			"shortcut": func() {
+				line = fnLine() + 1
+				NotZeroMust(0)
+			},
+			"shortcut get": func() {
+				line = fnLine() + 1
+				NotZeroMustGet(0)
+			},
+		}
+		for name, fn := range cases {
+			t.Run(name, func(t *testing.T) {
+				me := fnRecover(fn)
+				tAssert.NotNil(t, me)
+				tAssert.True(t, strings.HasSuffix(me.Caller.File, "b_caller_test.go"), me.Caller.File)
+				tAssert.Equal(t, line, me.Caller.Line)
+				tAssert.Contains(t, me.Caller.Function, "Test_MustError")
+				tAssert.Equal(t, fmt.Sprintf("%s:%d", me.Caller.File, me.Caller.Line), me.Caller.String())
+				tAssert.Nil(t, me.Stack)
+			})
+		}
+	})
+
+	t.Run("wrapped error", func(t *testing.T) {
+		me := fnRecover(func() { Str().LenMax(2).Must("hello") })
+		tAssert.Equal(t, `length of "hello" expects to be less or equal to 2, got 5`, me.Error())
+		var ae *AssertionError
+		tAssert.True(t, errors.As(me, &ae))
+		tAssert.Equal(t, CodeLenMax, ae.Rule)
+
+		me = fnRecover(func() { Str().LenMax(2).LenMin(10).MustAll("hello") })
+		var es ValidationErrors
+		tAssert.True(t, errors.As(me, &es))
+		tAssert.Len(t, es, 2)
+	})
+
+	t.Run("format", func(t *testing.T) {
+		me := fnRecover(func() { Str().LenMax(2).Must("hello") })
+		msg := `length of "hello" expects to be less or equal to 2, got 5`
+		tAssert.Equal(t, msg, fmt.Sprintf("%s", me))
+		tAssert.Equal(t, msg, fmt.Sprintf("%v", me))
+		tAssert.Equal(t, fmt.Sprintf("%q", msg), fmt.Sprintf("%q", me))
+		tAssert.Equal(t, msg+"\n    at "+me.Caller.Function+" ("+me.Caller.String()+")", fmt.Sprintf("%+v", me))
+	})
+
+	t.Run("stack", func(t *testing.T) {
+		SetStackCapture(true)
+		me := fnRecover(func() { Str().LenMax(2).Must("hello") })
+		SetStackCapture(false)
+
+		tAssert.Greater(t, len(me.Stack), 1)
+		tAssert.Equal(t, me.Caller, me.Stack[0])
+		for _, l := range me.Stack {
+			tAssert.False(t, strings.HasSuffix(l.File, "b_assert.go"), l.File)
+		}
+
+		s := fmt.Sprintf("%+v", me)
+		tAssert.Equal(t, len(me.Stack), strings.Count(s, "\n    at "))
+		tAssert.Contains(t, s, "testing.tRunner")
+	})
+}
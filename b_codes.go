@@ -0,0 +1,145 @@
+package assert
+
+// Codes of the built-in rules.
+//
+// Each built-in rule reports its code in AssertionError.Rule and uses it as the key of its message in catalogs.
+// Codes are stable -- they are not changed between versions, so it is safe to rely on them in client code.
+//
+// Aliases report the code of the rule they are based on, e.g. `Str().Word()` reports CodeRegexp.
+
+// Common
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	// CodeCustom -- code of custom rules (see Custom), used only to describe chains --
+	// custom rules report their own errors.
+	CodeCustom = "Custom"
+	// CodeNot -- code of negated rules (see Not). The "rule" parameter is the code of the negated rule,
+	// the "expected" fact is its expectation shown in messages, e.g. `Eq rule (equal to 7)`.
+	CodeNot = "Not"
+)
+
+// Comparable
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeEq    = "Eq"
+	CodeNotEq = "NotEq"
+	CodeIn    = "In"
+	CodeNotIn = "NotIn"
+)
+
+// Ordered
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeLess          = "Less"
+	CodeLessEq        = "LessEq"
+	CodeLessAny       = "LessAny"
+	CodeLessEqAny     = "LessEqAny"
+	CodeLessEach      = "LessEach"
+	CodeLessEqEach    = "LessEqEach"
+	CodeGreater       = "Greater"
+	CodeGreaterEq     = "GreaterEq"
+	CodeGreaterAny    = "GreaterAny"
+	CodeGreaterEqAny  = "GreaterEqAny"
+	CodeGreaterEach   = "GreaterEach"
+	CodeGreaterEqEach = "GreaterEqEach"
+	CodeInRange       = "InRange"
+	CodeNotInRange    = "NotInRange"
+)
+
+// Len
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeLenEq         = "LenEq"
+	CodeLenNotEq      = "LenNotEq"
+	CodeLenMin        = "LenMin"
+	CodeLenMax        = "LenMax"
+	CodeLenInRange    = "LenInRange"
+	CodeLenNotInRange = "LenNotInRange"
+)
+
+// Slice
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeEmpty                = "Empty"
+	CodeNotEmpty             = "NotEmpty"
+	CodeCustomElementAny     = "CustomElementAny"
+	CodeCustomElementEach    = "CustomElementEach"
+	CodeCustomElementNone    = "CustomElementNone"
+	CodeContains             = "Contains"
+	CodeNotContains          = "NotContains"
+	CodeContainsAny          = "ContainsAny"
+	CodeContainsEach         = "ContainsEach"
+	CodeContainsNone         = "ContainsNone"
+	CodeUniques              = "Uniques"
+	CodeUniquesLenEq         = "UniquesLenEq"
+	CodeUniquesLenNotEq      = "UniquesLenNotEq"
+	CodeUniquesLenMin        = "UniquesLenMin"
+	CodeUniquesLenMax        = "UniquesLenMax"
+	CodeUniquesLenInRange    = "UniquesLenInRange"
+	CodeUniquesLenNotInRange = "UniquesLenNotInRange"
+)
+
+// String
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodePrefixEq        = "PrefixEq"
+	CodePrefixNotEq     = "PrefixNotEq"
+	CodePrefixIn        = "PrefixIn"
+	CodePrefixNotIn     = "PrefixNotIn"
+	CodeSuffixEq        = "SuffixEq"
+	CodeSuffixNotEq     = "SuffixNotEq"
+	CodeSuffixIn        = "SuffixIn"
+	CodeSuffixNotIn     = "SuffixNotIn"
+	CodeContainsStr     = "ContainsStr"
+	CodeNotContainsStr  = "NotContainsStr"
+	CodeContainsStrAny  = "ContainsStrAny"
+	CodeContainsStrEach = "ContainsStrEach"
+	CodeContainsStrNone = "ContainsStrNone"
+	CodeRunesEq         = "RunesEq"
+	CodeRunesNotEq      = "RunesNotEq"
+	CodeRunesMin        = "RunesMin"
+	CodeRunesMax        = "RunesMax"
+	CodeRunesInRange    = "RunesInRange"
+	CodeRunesNotInRange = "RunesNotInRange"
+	CodeRegexp          = "Regexp"
+)
+
+// Any
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeNotZero    = "NotZero"
+	CodeNotNilDeep = "NotNilDeep"
+)
+
+// Logic
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeAnyOf     = "AnyOf"
+	CodeOneOf     = "OneOf"
+	CodeOneOfMany = "OneOfMany"
+	CodeAllOf     = "AllOf"
+	CodeNoneOf    = "NoneOf"
+)
+
+// Conditional rules report errors of their sub-chains as is -- their codes are used only to describe chains.
+const (
+	CodeWhen         = "When"
+	CodeUnless       = "Unless"
+	CodeWhenPasses   = "WhenPasses"
+	CodeUnlessPasses = "UnlessPasses"
+)
+
+// Context
+// ---------------------------------------------------------------------------------------------------------------------
+
+const (
+	CodeTimeout = "Timeout"
+)
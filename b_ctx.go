@@ -0,0 +1,92 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTimeout
+//
+// Sentinel of errors of rules, that did not complete within their timeouts -- see Timeout.
+//
+// Such errors are AssertionError with CodeTimeout and the "timeout" parameter.
+// They satisfy both `errors.Is(err, ErrTimeout)` and `errors.Is(err, context.DeadlineExceeded)`,
+// but only ErrTimeout distinguishes them from the deadline of the context of the result method.
+var ErrTimeout = fmt.Errorf("assert: rule timeout: %w", context.DeadlineExceeded)
+
+// run
+//
+// Runs the check of the rule with its timeout, if set.
+//
+// The check with a timeout runs in a separate goroutine, so the timeout is respected
+// even if the check ignores the context.
+func (r *rule[T]) run(ctx context.Context, v T) error {
+	return r.runCheck(ctx, v, r.check)
+}
+
+// runAll
+//
+// Works same as run, but runs the check of the rule for CheckAll-family methods, if set -- see setLastCheckAll.
+func (r *rule[T]) runAll(ctx context.Context, v T) error {
+	if r.checkAll == nil {
+		return r.run(ctx, v)
+	}
+	return r.runCheck(ctx, v, r.checkAll)
+}
+
+// runCheck
+//
+// Runs the given check of the rule with the timeout of the rule, if set.
+func (r *rule[T]) runCheck(ctx context.Context, v T, check func(ctx context.Context, v T) error) error {
+	if r.timeout <= 0 {
+		return check(ctx, v)
+	}
+
+	rCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	type result struct {
+		err      error
+		panicked bool
+		p        any
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- result{panicked: true, p: p}
+			}
+		}()
+		done <- result{err: check(rCtx, v)}
+	}()
+
+	select {
+	case res := <-done:
+		if res.panicked {
+			panic(res.p)
+		}
+		if res.err != nil && ctx.Err() == nil && rCtx.Err() != nil && errors.Is(res.err, context.DeadlineExceeded) {
+			return mkTimeoutErr(r.timeout, v)
+		}
+		return res.err
+	case <-rCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return mkTimeoutErr(r.timeout, v)
+	}
+}
+
+func mkTimeoutErr(timeout time.Duration, v any) error {
+	return sentinelWrapper(ErrTimeout)(mkCheckErr(CodeTimeout, map[string]any{"timeout": timeout}, v, nil, nil))
+}
+
+// isCtxErr
+//
+// Returns true, if the error is caused by the cancellation of the context.
+func isCtxErr(ctx context.Context, err error) bool {
+	ctxErr := ctx.Err()
+	return ctxErr != nil && errors.Is(err, ctxErr) && !errors.Is(err, ErrTimeout)
+}
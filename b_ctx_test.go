@@ -0,0 +1,125 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_Ctx(t *testing.T) {
+	type ctxKey struct{}
+
+	errTaken := errors.New("taken")
+
+	fnRegistered := func(ctx context.Context, v string) error {
+		if ctx.Value(ctxKey{}) == v {
+			return errTaken
+		}
+		return nil
+	}
+
+	fnSlow := func(ctx context.Context, v string) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return nil
+		}
+	}
+
+	t.Run("CustomCtx", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ctxKey{}, "bob")
+		a := Str().Named("login").LenMin(2).CustomCtx(fnRegistered)
+
+		tAssert.NoError(t, a.CheckCtx(ctx, "alice"))
+		err := a.CheckCtx(ctx, "bob")
+		tAssert.ErrorIs(t, err, errTaken)
+		tAssert.Equal(t, "login: taken", err.Error())
+		tAssert.Equal(t, `bad "bob"`, a.CheckCtx(ctx, "bob", "bad {value}").Error())
+		tAssert.Len(t, a.CheckAllCtx(ctx, "b"), 1)
+		tAssert.Len(t, a.CheckAllCtx(ctx, "bob"), 1)
+		tAssert.ErrorIs(t, a.CheckAllErrCtx(ctx, "bob"), errTaken)
+		tAssert.Nil(t, a.CheckAllErrCtx(ctx, "alice"))
+		tAssert.PanicsWithError(t, "login: taken", func() { a.MustCtx(ctx, "bob") })
+		tAssert.PanicsWithError(t, "login: taken", func() { a.MustAllCtx(ctx, "bob") })
+		tAssert.NotPanics(t, func() { a.MustCtx(ctx, "alice") })
+		tAssert.NotPanics(t, func() { a.MustAllCtx(ctx, "alice") })
+
+		// results without context
+		tAssert.NoError(t, a.Check("bob"))
+
+		tAssert.Panics(t, func() { Str().CustomCtx(nil) })
+	})
+
+	t.Run("all types", func(t *testing.T) {
+		fnFail := func(ctx context.Context, v time.Duration) error { return errTaken }
+		tAssert.ErrorIs(t, TimeDur().CustomCtx(fnFail).Check(time.Second), errTaken)
+		tAssert.ErrorIs(t, TimeDur().Custom(func(v time.Duration) error { return errTaken }).Check(0), errTaken)
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		called := false
+		a := Str().LenMax(1).Custom(func(v string) error { called = true; return nil })
+
+		tAssert.ErrorIs(t, a.CheckCtx(ctx, "abc"), context.Canceled)
+		errs := a.CheckAllCtx(ctx, "abc")
+		tAssert.Len(t, errs, 1)
+		tAssert.ErrorIs(t, errs[0], context.Canceled)
+		tAssert.False(t, called)
+
+		// cancellation during the check
+		ctx, cancel = context.WithCancel(context.Background())
+		a = Str().Named("v").CustomCtx(func(ctx context.Context, v string) error { cancel(); return ctx.Err() }).LenMax(1)
+		err := a.CheckCtx(ctx, "abc")
+		tAssert.Equal(t, context.Canceled, err)
+		errs = a.CheckAllCtx(ctx, "abc")
+		tAssert.Equal(t, []error{context.Canceled}, errs)
+
+		// Must
+		tAssert.Panics(t, func() { a.MustCtx(ctx, "abc") })
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		a := Str().Named("login").CustomCtx(fnSlow).Timeout(10 * time.Millisecond)
+
+		err := a.Check("bob")
+		tAssert.ErrorIs(t, err, ErrTimeout)
+		tAssert.ErrorIs(t, err, context.DeadlineExceeded)
+		tAssert.Equal(t, `login: check of "bob" expects to complete within 10ms`, err.Error())
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeTimeout, ae.Rule)
+		tAssert.Equal(t, map[string]any{"timeout": 10 * time.Millisecond}, ae.Params)
+
+		// rules ignoring the context
+		b := Str().Custom(func(v string) error { time.Sleep(time.Second); return nil }).Timeout(10 * time.Millisecond)
+		tAssert.ErrorIs(t, b.Check("bob"), ErrTimeout)
+
+		// fast rules
+		c := Str().CustomCtx(fnRegistered).Timeout(time.Second)
+		tAssert.NoError(t, c.Check("bob"))
+		tAssert.ErrorIs(t, c.CheckCtx(context.WithValue(context.Background(), ctxKey{}, "bob"), "bob"), errTaken)
+
+		// deadline of the context is not a timeout of the rule
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err = Str().CustomCtx(fnSlow).Timeout(time.Second).CheckCtx(ctx, "bob")
+		tAssert.ErrorIs(t, err, context.DeadlineExceeded)
+		tAssert.NotErrorIs(t, err, ErrTimeout)
+
+		// panics are propagated
+		d := Str().Custom(func(v string) error { panic("boom") }).Timeout(time.Second)
+		tAssert.PanicsWithValue(t, "boom", func() { _ = d.Check("bob") })
+
+		// zero disables the limit
+		tAssert.NoError(t, Str().LenMax(5).Timeout(0).Check("bob"))
+
+		tAssert.Panics(t, func() { Str().Timeout(time.Second) })
+		tAssert.Panics(t, func() { Str().LenMax(1).Timeout(-1) })
+	})
+}
@@ -0,0 +1,360 @@
+package assert
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// #####################################################################################################################
+// DESCRIPTION
+// #####################################################################################################################
+
+// RuleInfo
+//
+// Metadata of a registered rule -- see Describe.
+type RuleInfo struct {
+	// Name -- name of the rule method, e.g. "LenMax" or "Word" for aliases of built-in rules.
+	Name string `json:"name"`
+	// Code -- code of the rule, e.g. CodeLenMax or CodeRegexp for Word. CodeCustom for custom rules.
+	Code string `json:"code"`
+	// Params -- parameters of the rule by their names. Operands evaluated on each check ("Fn" variants)
+	// are evaluated on the call of Describe.
+	Params map[string]any `json:"params,omitempty"`
+	// CustomMsg -- custom message of the rule, if provided.
+	CustomMsg string `json:"customMsg,omitempty"`
+	// Negated -- true, if the rule is negated, see Not.
+	Negated bool `json:"negated,omitempty"`
+	// Warning -- true, if the rule is a warning, see Warn.
+	Warning bool `json:"warning,omitempty"`
+	// Groups -- groups of the rule, see Groups.
+	Groups []string `json:"groups,omitempty"`
+	// Summary -- human-readable description of the rule, e.g. "length <= 5".
+	Summary string `json:"summary"`
+}
+
+// Description
+//
+// Description of an assertion chain -- see Describe.
+type Description struct {
+	// Type -- type of the checked value, e.g. "string".
+	Type string `json:"type"`
+	// Path -- name of the checked value, if provided -- see Named.
+	Path string `json:"path,omitempty"`
+	// Rules -- registered rules in their order.
+	Rules []RuleInfo `json:"rules"`
+	// Summary -- human-readable description of the chain, e.g. "string; matches Word; length <= 5".
+	Summary string `json:"summary"`
+}
+
+// String
+//
+// Returns the summary of the chain.
+func (d *Description) String() string {
+	return d.Summary
+}
+
+// Describe
+//
+// Returns the description of registered rules -- e.g. to render validation hints in UIs
+// or to generate documentation of APIs from the same chains, that check values:
+//
+//	assert.Str().Word().LenMax(5).Describe().Summary // "string; matches Word; length <= 5"
+//
+// Summaries of rules are in English and use the formatter of the chain for parameters (see Formatter).
+// Summaries of warnings are marked with "(warning)".
+func (a *assert[T]) Describe() *Description {
+	d := &Description{
+		Type:  typeName[T](),
+		Path:  a.name,
+		Rules: make([]RuleInfo, 0, len(a.rules)),
+	}
+
+	summaries := make([]string, 0, len(a.rules)+1)
+	summaries = append(summaries, d.Type)
+	for _, r := range a.rules {
+		info := a.ruleInfo(r, evalParams(r.params))
+		d.Rules = append(d.Rules, info)
+		summaries = append(summaries, info.Summary+ternary(info.Warning, " (warning)", ""))
+	}
+	d.Summary = strings.Join(summaries, "; ")
+
+	return d
+}
+
+// ruleInfo
+//
+// Returns metadata of the rule with the given evaluated parameters -- see evalParams.
+func (a *assert[T]) ruleInfo(r *rule[T], params map[string]any) RuleInfo {
+	info := RuleInfo{
+		Name:      ternary(r.name != "", r.name, r.code),
+		Code:      r.code,
+		Params:    params,
+		CustomMsg: customMsg(r.customMsg),
+		Negated:   r.not,
+		Warning:   r.severity == SeverityWarning,
+		Groups:    append([]string(nil), r.groups...),
+	}
+	if len(info.Groups) == 0 {
+		info.Groups = nil
+	}
+
+	tpl := ruleSummaryTpl(info.Name, info.Code)
+	info.Summary = renderMsgTemplate(tpl, MessageArgs{Params: info.Params, formatter: a.formatter}, nil)
+	if info.Negated {
+		info.Summary = "not (" + info.Summary + ")"
+	}
+
+	return info
+}
+
+// typeName
+//
+// Returns the name of the type, e.g. "string" or "time.Time".
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// ruleSummaryTpl
+//
+// Returns the template of the summary of the rule by its name or code -- the name itself, if there is no template.
+func ruleSummaryTpl(name, code string) string {
+	if tpl, ok := ruleSummaries[name]; ok {
+		return tpl
+	}
+	if tpl, ok := ruleSummaries[code]; ok {
+		return tpl
+	}
+	return name
+}
+
+// ruleSummaries -- templates of summaries of rules by names of aliases and by codes -- see MessageTemplates.
+var ruleSummaries = map[string]string{
+	// common
+	CodeCustom: "custom check",
+
+	// aliases (and codes of same names, e.g. CodeEmpty)
+	"Word":     "matches Word",
+	"Numeric":  "matches Numeric",
+	"Empty":    "empty",
+	"NotEmpty": "not empty",
+	"Zero":     "zero",
+	"NotZero":  "not zero",
+	"Positive": "positive",
+	"Negative": "negative",
+	"True":     "true",
+	"False":    "false",
+
+	// comparable
+	CodeEq:    "equal to {eq}",
+	CodeNotEq: "not equal to {notEq}",
+	CodeIn:    "one of {in}",
+	CodeNotIn: "none of {notIn}",
+
+	// ordered
+	CodeLess:          "< {than}",
+	CodeLessEq:        "<= {than}",
+	CodeLessAny:       "< any of {elems}",
+	CodeLessEqAny:     "<= any of {elems}",
+	CodeLessEach:      "< each of {elems}",
+	CodeLessEqEach:    "<= each of {elems}",
+	CodeGreater:       "> {than}",
+	CodeGreaterEq:     ">= {than}",
+	CodeGreaterAny:    "> any of {elems}",
+	CodeGreaterEqAny:  ">= any of {elems}",
+	CodeGreaterEach:   "> each of {elems}",
+	CodeGreaterEqEach: ">= each of {elems}",
+	CodeInRange:       "in range [{min}, {max}]",
+	CodeNotInRange:    "not in range [{min}, {max}]",
+
+	// len
+	CodeLenEq:         "length = {eq}",
+	CodeLenNotEq:      "length != {notEq}",
+	CodeLenMin:        "length >= {min}",
+	CodeLenMax:        "length <= {max}",
+	CodeLenInRange:    "length in range [{min}, {max}]",
+	CodeLenNotInRange: "length not in range [{min}, {max}]",
+
+	// slice
+	CodeCustomElementAny:     "any element is {condition}",
+	CodeCustomElementEach:    "each element is {condition}",
+	CodeCustomElementNone:    "no element is {condition}",
+	CodeContains:             "contains {elem}",
+	CodeNotContains:          "does not contain {elem}",
+	CodeContainsAny:          "contains any of {elems}",
+	CodeContainsEach:         "contains each of {elems}",
+	CodeContainsNone:         "contains none of {elems}",
+	CodeUniques:              "unique elements",
+	CodeUniquesLenEq:         "unique elements = {eq}",
+	CodeUniquesLenNotEq:      "unique elements != {notEq}",
+	CodeUniquesLenMin:        "unique elements >= {min}",
+	CodeUniquesLenMax:        "unique elements <= {max}",
+	CodeUniquesLenInRange:    "unique elements in range [{min}, {max}]",
+	CodeUniquesLenNotInRange: "unique elements not in range [{min}, {max}]",
+
+	// string
+	CodePrefixEq:        "starts with {eq}",
+	CodePrefixNotEq:     "does not start with {notEq}",
+	CodePrefixIn:        "starts with any of {in}",
+	CodePrefixNotIn:     "starts with none of {notIn}",
+	CodeSuffixEq:        "ends with {eq}",
+	CodeSuffixNotEq:     "does not end with {notEq}",
+	CodeSuffixIn:        "ends with any of {in}",
+	CodeSuffixNotIn:     "ends with none of {notIn}",
+	CodeContainsStr:     "contains {substr}",
+	CodeNotContainsStr:  "does not contain {substr}",
+	CodeContainsStrAny:  "contains any of {substrs}",
+	CodeContainsStrEach: "contains each of {substrs}",
+	CodeContainsStrNone: "contains none of {substrs}",
+	CodeRunesEq:         "runes = {eq}",
+	CodeRunesNotEq:      "runes != {notEq}",
+	CodeRunesMin:        "runes >= {min}",
+	CodeRunesMax:        "runes <= {max}",
+	CodeRunesInRange:    "runes in range [{min}, {max}]",
+	CodeRunesNotInRange: "runes not in range [{min}, {max}]",
+	CodeRegexp:          "matches {pattern}",
+
+	// any
+	CodeNotNilDeep: "not nil",
+
+	// logic
+	CodeAnyOf:        "any of {count} alternatives",
+	CodeOneOf:        "exactly one of {count} alternatives",
+	CodeAllOf:        "all of {count} alternatives",
+	CodeNoneOf:       "none of {count} alternatives",
+	CodeWhen:         "conditional rules",
+	CodeUnless:       "conditional rules",
+	CodeWhenPasses:   "conditional rules",
+	CodeUnlessPasses: "conditional rules",
+}
+
+// #####################################################################################################################
+// EXPLANATION
+// #####################################################################################################################
+
+// RuleResult
+//
+// Result of a registered rule for a value -- see Explain.
+type RuleResult struct {
+	RuleInfo
+	// Passed -- true, if the rule passes.
+	Passed bool `json:"passed"`
+	// Skipped -- true, if the rule is not run -- it is not in groups selected in the context (see WithGroups)
+	// or the context is canceled.
+	Skipped bool `json:"skipped,omitempty"`
+	// Err -- error of the failed rule -- same as in CheckAll-family methods,
+	// ValidationErrors for several errors of a sub-chain (e.g. When).
+	Err error `json:"-"`
+	// Error -- message of the error of the failed rule.
+	Error string `json:"error,omitempty"`
+}
+
+// Explanation
+//
+// Results of all registered rules for a value -- see Explain.
+type Explanation struct {
+	// Rules -- results of registered rules in their order.
+	Rules []RuleResult `json:"rules"`
+}
+
+// Valid
+//
+// Returns true, if no rules failed, except for warnings -- see Warn.
+func (e *Explanation) Valid() bool {
+	for _, r := range e.Rules {
+		if !r.Passed && !r.Skipped && !r.Warning {
+			return false
+		}
+	}
+	return true
+}
+
+// String
+//
+// Returns results of rules line by line, e.g.
+//
+//	pass: matches Word
+//	fail: length <= 5 -- length of "abcdefg" expects to be less or equal to 5, got 7
+func (e *Explanation) String() string {
+	lines := make([]string, 0, len(e.Rules))
+	for _, r := range e.Rules {
+		switch {
+		case r.Skipped:
+			lines = append(lines, "skip: "+r.Summary)
+		case r.Passed:
+			lines = append(lines, "pass: "+r.Summary)
+		default:
+			lines = append(lines, ternary(r.Warning, "warn: ", "fail: ")+r.Summary+" -- "+r.Error)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Explain
+//
+// Runs each registered rule against the given value and returns results of all rules --
+// e.g. to show which requirements are met in UIs.
+//
+// Unlike CheckAll, all rules are run including warnings -- Bail, DependsOn and MaxErrors are ignored.
+// Errors of failed rules are same as in CheckAll-family methods.
+func (a *assert[T]) Explain(v T) *Explanation {
+	return a.ExplainCtx(context.Background(), v)
+}
+
+// ExplainCtx
+//
+// Works same as Explain, but passes the context to the checks (see CustomCtx) and stops on its cancellation --
+// remaining rules are skipped.
+//
+// Rules of groups not selected in the context are skipped -- see WithGroups.
+func (a *assert[T]) ExplainCtx(ctx context.Context, v T) *Explanation {
+	e := &Explanation{Rules: make([]RuleResult, 0, len(a.rules))}
+	selected := selectedGroups(ctx)
+	for _, r := range a.rules {
+		// dynamic operands of the rule are evaluated once -- the summary and the error report the compared values
+		opCtx := ctx
+		if hasDynamicParams(r.params) {
+			opCtx = withOperandValues(ctx)
+		}
+
+		res := RuleResult{RuleInfo: a.ruleInfo(r, evalParamsCtx(opCtx, r.params))}
+		if ctx.Err() != nil || !r.isSelected(selected) {
+			res.Skipped = true
+			e.Rules = append(e.Rules, res)
+			continue
+		}
+
+		err := r.runAll(opCtx, v)
+		switch {
+		case err == nil:
+			res.Passed = true
+		case isCtxErr(ctx, err):
+			res.Skipped = true
+		default:
+			res.Err = a.explainedErr(r, err)
+			res.Error = res.Err.Error()
+		}
+		e.Rules = append(e.Rules, res)
+	}
+	return e
+}
+
+// explainedErr
+//
+// Returns the error of the failed rule same as CheckAll-family methods --
+// errors of sub-chains (subErrs) are returned as ValidationErrors, if there are several of them.
+func (a *assert[T]) explainedErr(r *rule[T], err error) error {
+	sub, ok := err.(subErrs)
+	if !ok {
+		return a.wrappedErr(r, a.checkErr(err))
+	}
+	if len(sub) == 1 {
+		return a.wrappedErr(r, a.checkErr(sub[0]))
+	}
+
+	errs := make(ValidationErrors, 0, len(sub))
+	for _, err := range sub {
+		errs = append(errs, a.wrappedErr(r, a.checkErr(err)))
+	}
+	return errs
+}
@@ -0,0 +1,155 @@
+package assert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Describe(t *testing.T) {
+	t.Run("Summary", func(t *testing.T) {
+		tAssert.Equal(t, "string; matches Word; length <= 5", Str().Word().LenMax(5).Describe().Summary)
+		tAssert.Equal(t, "string; matches Word; length <= 5", Str().Word().LenMax(5).Describe().String())
+		tAssert.Equal(t, "string", Str().Describe().Summary)
+		tAssert.Equal(t, "int; positive; <= 10", Num[int]().Positive().LessEq(10).Describe().Summary)
+		tAssert.Equal(
+			t,
+			`string; not (starts with "x"); runes in range [1, 3] (warning); custom check`,
+			Str().Not().PrefixEq("x").RunesInRange(1, 3).Warn().Custom(func(v string) error { return nil }).
+				Describe().Summary,
+		)
+		tAssert.Equal(t, "[]int; not empty; unique elements", SliceCmp[[]int]().NotEmpty().Uniques().Describe().Summary)
+		tAssert.Equal(t, "int; <= 3", Num[int]().LessEqFn(func() int { return 3 }).Describe().Summary)
+	})
+
+	t.Run("Rules", func(t *testing.T) {
+		d := Str().Named("login").
+			Word("Only letters!").
+			LenMax(5).Groups("create").
+			Describe()
+
+		tAssert.Equal(t, "string", d.Type)
+		tAssert.Equal(t, "login", d.Path)
+		tAssert.Equal(
+			t,
+			[]RuleInfo{
+				{
+					Name:      "Word",
+					Code:      CodeRegexp,
+					Params:    map[string]any{"pattern": StringRegexpWord.String()},
+					CustomMsg: "Only letters!",
+					Summary:   "matches Word",
+				},
+				{
+					Name:    "LenMax",
+					Code:    CodeLenMax,
+					Params:  map[string]any{"max": 5},
+					Groups:  []string{"create"},
+					Summary: "length <= 5",
+				},
+			},
+			d.Rules,
+		)
+
+		js, err := json.Marshal(Num[int]().Positive().Not().Zero().Warn().Describe())
+		tAssert.NoError(t, err)
+		tAssert.JSONEq(
+			t,
+			`{"type":"int","summary":"int; positive; not (zero) (warning)","rules":[`+
+				`{"name":"Positive","code":"Greater","params":{"than":0},"summary":"positive"},`+
+				`{"name":"Zero","code":"Eq","params":{"eq":0},"negated":true,"warning":true,"summary":"not (zero)"}`+
+				`]}`,
+			string(js),
+		)
+	})
+
+	t.Run("Use", func(t *testing.T) {
+		base := Str().Word().Freeze()
+		tAssert.Equal(t, "string; matches Word; length >= 2", Str().Use(base).LenMin(2).Describe().Summary)
+		tAssert.Equal(t, "string; custom check", Str().Use(testChecker[string](nil)).Describe().Summary)
+	})
+}
+
+func Test_Explain(t *testing.T) {
+	errSentinel := errors.New("sentinel")
+
+	a := Str().Named("login").
+		Word().
+		LenMax(5).Err(errSentinel).
+		RunesMin(10).Warn().
+		PrefixEq("x").Groups("create")
+
+	t.Run("Explain", func(t *testing.T) {
+		e := a.Explain("abcdefg")
+		tAssert.False(t, e.Valid())
+		tAssert.Len(t, e.Rules, 4)
+
+		tAssert.True(t, e.Rules[0].Passed)
+		tAssert.NoError(t, e.Rules[0].Err)
+
+		tAssert.False(t, e.Rules[1].Passed)
+		tAssert.ErrorIs(t, e.Rules[1].Err, errSentinel)
+		tAssert.Equal(t, `login: length of "abcdefg" expects to be less or equal to 5, got 7`, e.Rules[1].Error)
+
+		tAssert.False(t, e.Rules[2].Passed)
+		tAssert.True(t, e.Rules[2].Warning)
+		tAssert.False(t, e.Rules[3].Passed)
+
+		tAssert.Equal(
+			t,
+			"pass: matches Word\n"+
+				`fail: length <= 5 -- login: length of "abcdefg" expects to be less or equal to 5, got 7`+"\n"+
+				`warn: runes >= 10 -- login: runes count of "abcdefg" expects to be greater or equal to 10, got 7`+"\n"+
+				`fail: starts with "x" -- login: value expects to have prefix equal to "x", got "abcdefg"`,
+			e.String(),
+		)
+
+		e = a.Explain("xab")
+		tAssert.True(t, e.Valid())
+		tAssert.False(t, e.Rules[2].Passed)
+	})
+
+	t.Run("ExplainCtx", func(t *testing.T) {
+		e := a.ExplainCtx(WithGroups(context.Background(), "update"), "abc")
+		tAssert.True(t, e.Valid())
+		tAssert.True(t, e.Rules[3].Skipped)
+		tAssert.Contains(t, e.String(), `skip: starts with "x"`)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		e = a.ExplainCtx(ctx, "abcdefg")
+		tAssert.True(t, e.Valid())
+		for _, r := range e.Rules {
+			tAssert.True(t, r.Skipped)
+		}
+	})
+
+	t.Run("sub-chains", func(t *testing.T) {
+		b := Str().When(func(v string) bool { return true }, Str().LenMax(2).PrefixEq("x"))
+		e := b.Explain("abc")
+		tAssert.False(t, e.Valid())
+
+		var ves ValidationErrors
+		tAssert.True(t, errors.As(e.Rules[0].Err, &ves))
+		tAssert.Equal(t, b.CheckAll("abc"), []error(ves))
+		tAssert.Equal(t, b.CheckAllErr("abc").Error(), e.Rules[0].Error)
+
+		e = b.Explain("xabc")
+		tAssert.Equal(t, b.CheckAll("xabc")[0], e.Rules[0].Err)
+	})
+
+	t.Run("dynamic operands", func(t *testing.T) {
+		n := 0
+		fnN := func() int { n++; return n }
+
+		e := Num[int]().LessEqFn(fnN).Explain(5)
+		tAssert.Equal(t, 1, n)
+		tAssert.Equal(t, "fail: <= 1 -- value expects to be less or equal to 1, got 5", e.String())
+
+		e = Num[int]().Not().LessEqFn(fnN).Explain(1)
+		tAssert.Equal(t, 2, n)
+		tAssert.Equal(t, "fail: not (<= 2) -- value expects not to match LessEq rule (<= 2), got 1", e.String())
+	})
+}
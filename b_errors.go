@@ -0,0 +1,444 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// #####################################################################################################################
+// ASSERTION ERROR
+// #####################################################################################################################
+
+// AssertionError
+//
+// Error of a failed built-in rule.
+//
+// Every built-in rule reports its failure with this type,
+// so it can be retrieved from any result error via errors.As.
+type AssertionError struct {
+	// Path -- name or path of the checked value, e.g. "deactivatedAt" or "user.emails[2]" -- see Named.
+	//
+	// Empty for unnamed chains.
+	Path string `json:"path,omitempty"`
+
+	// Rule -- code of the failed rule, e.g. CodeLenMax, CodeRegexp, CodeInRange.
+	//
+	// Aliases report the rule they are based on, e.g. `Str().Word()` reports CodeRegexp.
+	Rule string `json:"rule"`
+
+	// Params -- parameters of the failed rule by their names, e.g. {"min": 1, "max": 5}.
+	//
+	// Nil for rules without parameters.
+	Params map[string]any `json:"params,omitempty"`
+
+	// Value -- the checked value.
+	//
+	// Redacted for sensitive values -- see Sensitive.
+	Value any `json:"value"`
+
+	// Facts -- computed facts about the value by their names, e.g. {"len": 7}.
+	//
+	// Nil for rules without computed facts.
+	Facts map[string]any `json:"facts,omitempty"`
+
+	// DefaultMsg -- default message of the failed rule.
+	DefaultMsg string `json:"defaultMsg"`
+
+	// CustomMsg -- custom message, that replaced the default one, if provided.
+	CustomMsg string `json:"customMsg,omitempty"`
+
+	// customTpl -- template of the custom message, if provided, to re-render it when the path changes.
+	customTpl string
+
+	// formatter -- formatter of values of the chain, if set -- see Formatter.
+	formatter ValueFormatter
+}
+
+// Error
+//
+// Returns custom message, if provided, or default message prefixed with the path otherwise.
+func (e *AssertionError) Error() string {
+	if e.IsCustomMsg() {
+		return e.CustomMsg
+	}
+	return prefixMsgPath(e.Path, e.DefaultMsg)
+}
+
+// IsCustomMsg
+//
+// Returns true, if the default message has been replaced by a custom message.
+func (e *AssertionError) IsCustomMsg() bool {
+	return e.CustomMsg != ""
+}
+
+// LocalizedMsg
+//
+// Returns custom message, if provided, or default message in the given locale otherwise.
+//
+// See RegisterCatalog and SetTranslator.
+func (e *AssertionError) LocalizedMsg(locale string) string {
+	if e.IsCustomMsg() {
+		return e.CustomMsg
+	}
+	return prefixMsgPath(e.Path, translateMsg(locale, e.Rule, e.msgArgs()))
+}
+
+func (e *AssertionError) msgArgs() MessageArgs {
+	return MessageArgs{Path: e.Path, Value: e.Value, Params: e.Params, Facts: e.Facts, formatter: e.formatter}
+}
+
+// setCustomTpl
+//
+// Renders the custom message from the template -- see MessageTemplates.
+func (e *AssertionError) setCustomTpl(customTpl string) {
+	e.customTpl = customTpl
+	e.CustomMsg = renderMsgTemplate(customTpl, e.msgArgs(), localePlural(Locale()))
+}
+
+// withCustomTpl
+//
+// Returns a copy of the error with the custom message rendered from the template.
+func (e *AssertionError) withCustomTpl(customTpl string) *AssertionError {
+	c := *e
+	c.setCustomTpl(customTpl)
+	return &c
+}
+
+// withCustomMsg
+//
+// Returns a copy of the error with replaced custom message.
+func (e *AssertionError) withCustomMsg(customMsg string) *AssertionError {
+	c := *e
+	c.customTpl = ""
+	c.CustomMsg = customMsg
+	return &c
+}
+
+// withPath
+//
+// Returns a copy of the error with the path joined to the parent path.
+func (e *AssertionError) withPath(parent string) *AssertionError {
+	c := *e
+	c.Path = joinPath(parent, e.Path)
+	if c.customTpl != "" {
+		c.setCustomTpl(c.customTpl)
+	}
+	return &c
+}
+
+// withRedactedValue
+//
+// Returns a copy of the error with redacted value and messages -- see Sensitive.
+//
+// Facts derived from the value content (e.g. mismatched element or errors of alternatives) are redacted too.
+func (e *AssertionError) withRedactedValue() *AssertionError {
+	c := *e
+	c.Value = redactVal(e.Value)
+	elem, hasElem := e.Facts["elem"]
+	alts, hasAlts := e.Facts["errors"].(altErrs)
+	if hasElem || hasAlts {
+		c.Facts = make(map[string]any, len(e.Facts))
+		for k, f := range e.Facts {
+			c.Facts[k] = f
+		}
+	}
+	if hasElem {
+		c.Facts["elem"] = redactVal(elem)
+	}
+	if hasAlts {
+		redacted := make(altErrs, 0, len(alts))
+		for _, alt := range alts {
+			redacted = append(redacted, errRedacted(alt))
+		}
+		c.Facts["errors"] = redacted
+	}
+	c.render()
+	return &c
+}
+
+// withFormatter
+//
+// Returns a copy of the error with messages re-rendered by the formatter -- see Formatter.
+//
+// Errors that already have a formatter (e.g. from nested chains) are returned as is.
+func (e *AssertionError) withFormatter(f ValueFormatter) *AssertionError {
+	if e.formatter != nil {
+		return e
+	}
+	c := *e
+	c.formatter = f
+	c.render()
+	return &c
+}
+
+// render
+//
+// Re-renders the default message and the custom message template, if provided.
+func (e *AssertionError) render() {
+	e.DefaultMsg = translateMsg(Locale(), e.Rule, e.msgArgs())
+	if e.customTpl != "" {
+		e.setCustomTpl(e.customTpl)
+	}
+}
+
+// #####################################################################################################################
+// VALIDATION ERRORS
+// #####################################################################################################################
+
+// ValidationErrors
+//
+// Collection of errors from all failed checks of a chain -- see CheckAllErr and MustAll.
+//
+// Implements `error`, so it can be returned, logged or recovered as any other error.
+//
+// Supports errors.Is / errors.As for the contained errors:
+// via `Unwrap() []error` on Go 1.20+ and via own `Is` / `As` methods on earlier versions.
+type ValidationErrors []error
+
+// Error
+//
+// Returns the message of the single error or a multi-line list of all messages.
+func (es ValidationErrors) Error() string {
+	switch len(es) {
+	case 0:
+		return ""
+	case 1:
+		return es[0].Error()
+	}
+
+	b := strings.Builder{}
+	b.WriteString(fmt.Sprintf("%d validation errors:", len(es)))
+	for _, e := range es {
+		b.WriteString("\n  - ")
+		b.WriteString(strings.ReplaceAll(e.Error(), "\n", "\n    "))
+	}
+	return b.String()
+}
+
+// Unwrap
+//
+// Returns the contained errors -- used by errors.Is / errors.As since Go 1.20.
+func (es ValidationErrors) Unwrap() []error {
+	return es
+}
+
+// Is
+//
+// Reports whether any of the contained errors matches the target -- see errors.Is.
+//
+// Fallback for Go 1.18-1.19, which do not support `Unwrap() []error`.
+func (es ValidationErrors) Is(target error) bool {
+	for _, e := range es {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As
+//
+// Finds the first of the contained errors that matches the target -- see errors.As.
+//
+// Fallback for Go 1.18-1.19, which do not support `Unwrap() []error`.
+func (es ValidationErrors) As(target any) bool {
+	for _, e := range es {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON
+//
+// Marshals the errors to a list of objects with the "message" field.
+// Objects of AssertionError-based errors also contain all its fields.
+func (es ValidationErrors) MarshalJSON() ([]byte, error) {
+	type item struct {
+		Message string `json:"message"`
+		*AssertionError
+	}
+
+	items := make([]item, 0, len(es))
+	for _, e := range es {
+		it := item{Message: e.Error()}
+		errors.As(e, &it.AssertionError)
+		items = append(items, it)
+	}
+	return json.Marshal(items)
+}
+
+// #####################################################################################################################
+// MUST ERROR
+// #####################################################################################################################
+
+// MustError
+//
+// Failure of Must-family methods (Must, MustAll, MustGet, MustAllGet and Must-shortcuts) --
+// the error of the check with the location of the call.
+//
+// The error of the check (e.g. *AssertionError or ValidationErrors) is available via errors.As / errors.Is.
+//
+// Formatting with `%+v` adds the location of the caller -- or the full stack, if enabled via SetStackCapture.
+type MustError struct {
+	// Err -- the error of the check: error of Check for Must and ValidationErrors for MustAll.
+	Err error
+
+	// Caller -- location of the call of the Must-family method in the client code.
+	Caller Location
+
+	// Stack -- stack of calls starting from the caller.
+	//
+	// Nil, if the capture is not enabled -- see SetStackCapture.
+	Stack []Location
+}
+
+func newMustError(err error) *MustError {
+	caller, stack := callers()
+	return &MustError{Err: err, Caller: caller, Stack: stack}
+}
+
+// Error
+//
+// Returns the message of the error of the check.
+func (e *MustError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap
+//
+// Returns the error of the check.
+func (e *MustError) Unwrap() error {
+	return e.Err
+}
+
+// Format
+//
+// Supports `%s`, `%v`, `%q` as the message and `%+v` as the message with the location of the caller or the stack:
+//
+//	length of "hello" expects to be less or equal to 2, got 5
+//	    at github.com/user/app/user.(*Service).Deactivate (/app/user/service.go:42)
+func (e *MustError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		_, _ = io.WriteString(s, e.Error())
+		stack := e.Stack
+		if len(stack) == 0 {
+			stack = []Location{e.Caller}
+		}
+		for _, l := range stack {
+			_, _ = fmt.Fprintf(s, "\n    at %s (%s)", l.Function, l)
+		}
+	case verb == 'q':
+		_, _ = fmt.Fprintf(s, "%q", e.Error())
+	default:
+		_, _ = io.WriteString(s, e.Error())
+	}
+}
+
+// #####################################################################################################################
+// SENTINEL ERROR
+// #####################################################################################################################
+
+// sentinelError
+//
+// Error of a check marked with a sentinel error -- see Err and ChainErr.
+//
+// Keeps the message of the error and matches both the error and the sentinel via errors.Is / errors.As.
+type sentinelError struct {
+	err      error
+	sentinel error
+}
+
+// sentinelWrapper
+//
+// Returns the wrapper, that marks errors with the sentinel.
+func sentinelWrapper(sentinel error) func(err error) error {
+	return func(err error) error {
+		return &sentinelError{err: err, sentinel: sentinel}
+	}
+}
+
+// Error
+//
+// Returns the message of the original error.
+func (e *sentinelError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap
+//
+// Returns the original error.
+func (e *sentinelError) Unwrap() error {
+	return e.err
+}
+
+// Is
+//
+// Reports whether the sentinel matches the target -- see errors.Is.
+func (e *sentinelError) Is(target error) bool {
+	return errors.Is(e.sentinel, target)
+}
+
+// withErr
+//
+// Returns a copy of the error with replaced original error.
+func (e *sentinelError) withErr(err error) *sentinelError {
+	return &sentinelError{err: err, sentinel: e.sentinel}
+}
+
+// #####################################################################################################################
+// PATH
+// #####################################################################################################################
+
+// joinPath
+//
+// Joins the child path to the parent one: "user" + "email" -> "user.email", "emails" + "[2]" -> "emails[2]".
+func joinPath(parent string, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["):
+		return parent + child
+	default:
+		return parent + "." + child
+	}
+}
+
+func prefixMsgPath(path string, msg string) string {
+	if path == "" {
+		return msg
+	}
+	return path + ": " + msg
+}
+
+// errWithPath
+//
+// Joins the path to the paths of structured errors. Other errors are wrapped with the path prefix in the message.
+func errWithPath(err error, path string) error {
+	if err == nil || path == "" {
+		return err
+	}
+	switch e := err.(type) {
+	case *AssertionError:
+		return e.withPath(path)
+	case *sentinelError:
+		return e.withErr(errWithPath(e.err, path))
+	case ValidationErrors:
+		es := make(ValidationErrors, 0, len(e))
+		for _, ee := range e {
+			es = append(es, errWithPath(ee, path))
+		}
+		return es
+	default:
+		return fmt.Errorf("%s: %w", path, err)
+	}
+}
+
+// #####################################################################################################################
@@ -0,0 +1,193 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func Test_AssertionError(t *testing.T) {
+	fnAs := func(t *testing.T, err error) *AssertionError {
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		return ae
+	}
+
+	// Rules
+	// --------------------------------
+
+	t.Run("comparable", func(t *testing.T) {
+		ae := fnAs(t, Num[int]().In([]int{1, 2}).Check(3))
+		tAssert.Equal(t, "In", ae.Rule)
+		tAssert.Equal(t, map[string]any{"in": []int{1, 2}}, ae.Params)
+		tAssert.Equal(t, 3, ae.Value)
+		tAssert.False(t, ae.IsCustomMsg())
+		tAssert.Equal(t, ae.DefaultMsg, ae.Error())
+	})
+
+	t.Run("ordered", func(t *testing.T) {
+		ae := fnAs(t, Num[int]().InRange(1, 5).Check(7))
+		tAssert.Equal(t, "InRange", ae.Rule)
+		tAssert.Equal(t, map[string]any{"min": 1, "max": 5}, ae.Params)
+		tAssert.Equal(t, 7, ae.Value)
+
+		tAssert.Equal(t, "LessEq", fnAs(t, Num[int]().LessEq(1).Check(7)).Rule)
+		tAssert.Equal(t, "Less", fnAs(t, Num[int]().Less(1).Check(7)).Rule)
+		tAssert.Equal(t, "GreaterEqAny", fnAs(t, Num[int]().GreaterEqAny([]int{8}).Check(7)).Rule)
+	})
+
+	t.Run("len", func(t *testing.T) {
+		ae := fnAs(t, Str().LenMax(2).Check("hello"))
+		tAssert.Equal(t, "LenMax", ae.Rule)
+		tAssert.Equal(t, map[string]any{"max": 2}, ae.Params)
+		tAssert.Equal(t, "hello", ae.Value)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		ae := fnAs(t, SliceCmp[[]int]().Uniques().Check([]int{1, 1}))
+		tAssert.Equal(t, "Uniques", ae.Rule)
+		tAssert.Nil(t, ae.Params)
+		tAssert.Equal(t, []int{1, 1}, ae.Value)
+
+		ae = fnAs(t, SliceCmp[[]int]().ContainsEach([]int{2}).Check([]int{1}))
+		tAssert.Equal(t, "ContainsEach", ae.Rule)
+		tAssert.Equal(t, map[string]any{"elems": []int{2}}, ae.Params)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		ae := fnAs(t, Str().Regexp(regexp.MustCompile("^a$")).Check("b"))
+		tAssert.Equal(t, "Regexp", ae.Rule)
+		tAssert.Equal(t, map[string]any{"pattern": "^a$"}, ae.Params)
+		tAssert.Equal(t, "b", ae.Value)
+
+		tAssert.Equal(t, "Regexp", fnAs(t, Str().Word().Check("1")).Rule)
+		tAssert.Equal(t, "RunesMin", fnAs(t, Str().RunesMin(2).Check("1")).Rule)
+	})
+
+	// Custom messages
+	// --------------------------------
+
+	t.Run("rule custom message", func(t *testing.T) {
+		ae := fnAs(t, Str().LenMax(2, "too long").Check("hello"))
+		tAssert.True(t, ae.IsCustomMsg())
+		tAssert.Equal(t, "too long", ae.Error())
+		tAssert.NotEmpty(t, ae.DefaultMsg)
+		tAssert.NotEqual(t, ae.DefaultMsg, ae.Error())
+	})
+
+	t.Run("result custom message", func(t *testing.T) {
+		ae := fnAs(t, Str().LenMax(2, "too long").Check("hello", "incorrect"))
+		tAssert.Equal(t, "LenMax", ae.Rule)
+		tAssert.True(t, ae.IsCustomMsg())
+		tAssert.Equal(t, "incorrect", ae.Error())
+
+		tAssert.PanicsWithError(t, "incorrect", func() { Str().LenMax(2).Must("hello", "incorrect") })
+	})
+
+	t.Run("custom rule", func(t *testing.T) {
+		rErr := errors.New("custom")
+
+		err := Str().Custom(func(v string) error { return rErr }).Check("hello")
+		tAssert.Equal(t, rErr, err)
+
+		err = Str().Custom(func(v string) error { return rErr }).Check("hello", "incorrect")
+		var ae *AssertionError
+		tAssert.False(t, errors.As(err, &ae))
+		tAssert.Equal(t, "incorrect", err.Error())
+	})
+
+	t.Run("params are copied", func(t *testing.T) {
+		a := Str().LenMax(2)
+		var ae *AssertionError
+		tAssert.True(t, errors.As(a.Check("hello"), &ae))
+		ae.Params["max"] = 10
+
+		tAssert.Equal(t, "length of \"hello\" expects to be less or equal to 2, got 5", a.Check("hello").Error())
+		tAssert.Equal(t, "string; length <= 2", a.Describe().Summary)
+	})
+}
+
+func Test_ValidationErrors(t *testing.T) {
+	rErr1 := errors.New("result error 1")
+	rErr2 := errors.New("result error 2\nsecond line")
+
+	t.Run("Error", func(t *testing.T) {
+		tAssert.Equal(t, "", ValidationErrors{}.Error())
+		tAssert.Equal(t, "result error 1", ValidationErrors{rErr1}.Error())
+		tAssert.Equal(
+			t,
+			"2 validation errors:\n  - result error 1\n  - result error 2\n    second line",
+			ValidationErrors{rErr1, rErr2}.Error(),
+		)
+	})
+
+	t.Run("Is / As", func(t *testing.T) {
+		var err error = ValidationErrors{rErr1, Str().LenMax(2).Check("hello")}
+
+		tAssert.True(t, errors.Is(err, rErr1))
+		tAssert.False(t, errors.Is(err, rErr2))
+
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "LenMax", ae.Rule)
+
+		var ves ValidationErrors
+		tAssert.True(t, errors.As(err, &ves))
+		tAssert.Len(t, ves, 2)
+
+		// fallback methods
+		tAssert.True(t, err.(ValidationErrors).Is(rErr1))
+		tAssert.True(t, err.(ValidationErrors).As(&ae))
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+		err := Num[int]().Custom(func(v int) error { return rErr1 }).Less(1, "too big").CheckAllErr(5)
+
+		j, jErr := json.Marshal(err)
+		tAssert.NoError(t, jErr)
+		tAssert.JSONEq(
+			t,
+			`[
+				{"message": "result error 1"},
+				{
+					"message": "too big",
+					"rule": "Less",
+					"params": {"than": 1},
+					"value": 5,
+					"defaultMsg": `+fmt.Sprintf("%q", err.(ValidationErrors)[1].(*AssertionError).DefaultMsg)+`,
+					"customMsg": "too big"
+				}
+			]`,
+			string(j),
+		)
+	})
+
+	t.Run("CheckAllErr / MustAll", func(t *testing.T) {
+		a := Str().NotEmpty().Word()
+
+		tAssert.Nil(t, a.CheckAllErr("hello"))
+
+		err := a.CheckAllErr("")
+		tAssert.IsType(t, ValidationErrors{}, err)
+		tAssert.Len(t, err, 2)
+
+		tAssert.PanicsWithError(t, err.Error(), func() { a.MustAll("") })
+		tAssert.PanicsWithError(t, err.Error(), func() { a.MustAllGet("") })
+
+		// recovered values unwrap to ValidationErrors
+		fnRecoveredErrs := func(fn func()) (ves ValidationErrors) {
+			defer func() {
+				p := recover()
+				tAssert.IsType(t, &MustError{}, p)
+				tAssert.True(t, errors.As(p.(error), &ves))
+			}()
+			fn()
+			return nil
+		}
+		tAssert.Equal(t, err, fnRecoveredErrs(func() { a.MustAll("") }))
+		tAssert.Equal(t, err, fnRecoveredErrs(func() { a.MustAllGet("") }))
+	})
+}
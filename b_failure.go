@@ -0,0 +1,117 @@
+package assert
+
+import "sync"
+
+// FailureHandler
+//
+// Handles failures of Must-family methods (Must, MustAll, MustGet, MustAllGet and Must-shortcuts).
+//
+// If the handler returns (does not panic), the method returns as if the validation passed --
+// e.g. MustGet returns the value.
+//
+// Built-in handlers: PanicHandler (default), LogHandler, PanicValueHandler.
+// The handler can be set globally via SetFailureHandler or for a single chain via OnFailure.
+type FailureHandler func(err *MustError)
+
+// PanicHandler
+//
+// Panics with the error. Used by default.
+func PanicHandler(err *MustError) {
+	panic(err)
+}
+
+// LogHandler
+//
+// Returns the handler, that logs the error with the location of the call (see MustError.Format) and continues.
+//
+// "logf" is any Printf-like function -- e.g. log.Printf or (*log.Logger).Printf.
+//
+// Panics, if "logf" is nil.
+func LogHandler(logf func(format string, args ...any)) FailureHandler {
+	if logf == nil {
+		panic("LogHandler expects not nil logf")
+	}
+	return func(err *MustError) {
+		logf("%+v", err)
+	}
+}
+
+// PanicValueHandler
+//
+// Returns the handler, that panics with the value returned by "fn" --
+// e.g. with own error type expected by recovering middleware.
+//
+// Panics, if "fn" is nil.
+func PanicValueHandler(fn func(err *MustError) any) FailureHandler {
+	if fn == nil {
+		panic("PanicValueHandler expects not nil fn")
+	}
+	return func(err *MustError) {
+		panic(fn(err))
+	}
+}
+
+var failureHandler = struct {
+	mu sync.RWMutex
+	h  FailureHandler
+}{
+	h: PanicHandler,
+}
+
+// SetFailureHandler
+//
+// Sets the global handler of failures of Must-family methods. Nil restores the default PanicHandler.
+func SetFailureHandler(h FailureHandler) {
+	failureHandler.mu.Lock()
+	defer failureHandler.mu.Unlock()
+	failureHandler.h = ternary[FailureHandler](h != nil, h, PanicHandler)
+}
+
+func globalFailureHandler() FailureHandler {
+	failureHandler.mu.RLock()
+	defer failureHandler.mu.RUnlock()
+	return failureHandler.h
+}
+
+// Recover
+//
+// Converts panics of Must-family methods into the error -- to use the concise Must style inside a function,
+// that returns errors:
+//
+//	func Rename(name string) (err error) {
+//		defer assert.Recover(&err)
+//		assert.Str().RunesInRange(1, 32).Must(name)
+//		// ...
+//	}
+//
+// Recognises MustError (the default panic value), ValidationErrors, []error and *AssertionError.
+// Other panics are re-panicked as is.
+//
+// Must be deferred directly -- recover() does not work in nested calls.
+func Recover(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	rErr, ok := recoveredErr(r)
+	if !ok || err == nil {
+		panic(r)
+	}
+	*err = rErr
+}
+
+func recoveredErr(r any) (error, bool) {
+	switch e := r.(type) {
+	case *MustError:
+		return e, true
+	case ValidationErrors:
+		return e, true
+	case []error:
+		return ValidationErrors(e), true
+	case *AssertionError:
+		return e, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,165 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func Test_FailureHandler(t *testing.T) {
+	t.Cleanup(func() { SetFailureHandler(nil) })
+
+	const msg = `length of "hello" expects to be less or equal to 2, got 5`
+
+	t.Run("default", func(t *testing.T) {
+		tAssert.PanicsWithError(t, msg, func() { Str().LenMax(2).Must("hello") })
+		tAssert.NotPanics(t, func() { Str().LenMax(2).Must("hi") })
+	})
+
+	t.Run("LogHandler", func(t *testing.T) {
+		var logs []string
+		logf := func(format string, args ...any) { logs = append(logs, fmt.Sprintf(format, args...)) }
+
+		a := Str().LenMax(2).LenMin(1).OnFailure(LogHandler(logf))
+		tAssert.NotPanics(t, func() { a.Must("hello") })
+		tAssert.Equal(t, "hello", a.MustGet("hello"))
+		tAssert.Equal(t, "", a.MustAllGet(""))
+		tAssert.NotPanics(t, func() { a.MustAll("ok") })
+
+		tAssert.Len(t, logs, 3)
+		tAssert.True(t, strings.HasPrefix(logs[0], msg+"\n    at "), logs[0])
+		tAssert.Contains(t, logs[0], "b_failure_test.go")
+
+		tAssert.Panics(t, func() { LogHandler(nil) })
+	})
+
+	t.Run("PanicValueHandler", func(t *testing.T) {
+		type domainErr struct{ msg string }
+
+		a := Str().LenMax(2).OnFailure(PanicValueHandler(func(err *MustError) any { return domainErr{err.Error()} }))
+		tAssert.PanicsWithValue(t, domainErr{msg}, func() { a.Must("hello") })
+
+		// panics with errors of checks as is -- see BREAKING CHANGES in CHANGELOG
+		b := Str().LenMax(2).LenMin(10).OnFailure(PanicValueHandler(func(err *MustError) any { return err.Err }))
+		fnRecovered := func(fn func()) (r any) {
+			defer func() { r = recover() }()
+			fn()
+			return nil
+		}
+		tAssert.Equal(t, b.Check("hello"), fnRecovered(func() { b.Must("hello") }))
+		tAssert.Equal(t, b.CheckAllErr("hello"), fnRecovered(func() { b.MustAll("hello") }))
+		tAssert.IsType(t, ValidationErrors{}, fnRecovered(func() { b.MustAll("hello") }))
+
+		tAssert.Panics(t, func() { PanicValueHandler(nil) })
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		var reported []error
+		h := func(err *MustError) {
+			reported = append(reported, err)
+			PanicHandler(err)
+		}
+
+		a := Str().LenMax(2).OnFailure(h)
+		tAssert.PanicsWithError(t, msg, func() { a.Must("hello") })
+		tAssert.Len(t, reported, 1)
+		var ae *AssertionError
+		tAssert.True(t, errors.As(reported[0], &ae))
+	})
+
+	t.Run("global", func(t *testing.T) {
+		n := 0
+		SetFailureHandler(func(err *MustError) { n++ })
+		tAssert.NotPanics(t, func() { Str().LenMax(2).Must("hello") })
+		tAssert.NotPanics(t, func() { NotZeroMust(0) })
+		tAssert.Equal(t, 2, n)
+
+		// chain handler has priority
+		tAssert.Panics(t, func() { Str().LenMax(2).OnFailure(PanicHandler).Must("hello") })
+
+		SetFailureHandler(nil)
+		tAssert.Panics(t, func() { Str().LenMax(2).Must("hello") })
+
+		// nil restores the global handler
+		tAssert.Panics(t, func() { Str().LenMax(2).OnFailure(LogHandler(t.Logf)).OnFailure(nil).Must("hello") })
+	})
+}
+
+func Test_Recover(t *testing.T) {
+	fnRename := func(name string) (err error) {
+		defer Recover(&err)
+		Str().RunesInRange(1, 5).Must(name)
+		return nil
+	}
+
+	t.Run("no panic", func(t *testing.T) {
+		tAssert.NoError(t, fnRename("bob"))
+	})
+
+	t.Run("Must", func(t *testing.T) {
+		err := fnRename("alexander")
+		tAssert.EqualError(t, err, `runes count of "alexander" expects to be in range [1, 5], got 9`)
+		var me *MustError
+		tAssert.True(t, errors.As(err, &me))
+		tAssert.Contains(t, me.Caller.File, "b_failure_test.go")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeRunesInRange, ae.Rule)
+	})
+
+	t.Run("MustAll, MustGet, shortcuts", func(t *testing.T) {
+		fn := func(fnT func()) (err error) {
+			defer Recover(&err)
+			fnT()
+			return nil
+		}
+
+		var es ValidationErrors
+		tAssert.True(t, errors.As(fn(func() { Str().LenMin(3).LenMax(1).MustAll("ab") }), &es))
+		tAssert.Len(t, es, 2)
+		tAssert.Error(t, fn(func() { Str().LenMin(3).MustGet("ab") }))
+		tAssert.Error(t, fn(func() { NotZeroMust(0) }))
+	})
+
+	t.Run("legacy payloads", func(t *testing.T) {
+		fn := func(r any) (err error) {
+			defer Recover(&err)
+			panic(r)
+		}
+
+		rErr := errors.New("x")
+		tAssert.Equal(t, ValidationErrors{rErr}, fn([]error{rErr}))
+		tAssert.Equal(t, ValidationErrors{rErr}, fn(ValidationErrors{rErr}))
+		ae := &AssertionError{Rule: CodeEq}
+		tAssert.Equal(t, ae, fn(ae))
+	})
+
+	t.Run("unrelated panics", func(t *testing.T) {
+		fn := func(r any) (err error) {
+			defer Recover(&err)
+			panic(r)
+		}
+
+		tAssert.PanicsWithValue(t, "boom", func() { _ = fn("boom") })
+		tAssert.PanicsWithError(t, "x", func() { _ = fn(errors.New("x")) })
+
+		// typed panic values of custom failure handlers are not recognised
+		a := Str().LenMin(3).OnFailure(PanicValueHandler(func(err *MustError) any { return "custom" }))
+		tAssert.PanicsWithValue(t, "custom", func() {
+			_ = func() (err error) {
+				defer Recover(&err)
+				a.Must("ab")
+				return nil
+			}()
+		})
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		tAssert.Panics(t, func() {
+			defer Recover(nil)
+			Str().LenMin(3).Must("ab")
+		})
+	})
+}
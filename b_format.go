@@ -0,0 +1,334 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// #####################################################################################################################
+// INTERFACE
+// #####################################################################################################################
+
+// ValueFormatter
+//
+// Formats values (checked values, rule parameters and computed facts) in messages.
+//
+// The formatter can be set globally via SetValueFormatter or for a single chain via Formatter.
+type ValueFormatter interface {
+	FormatValue(v any) string
+}
+
+// ValueFormatterFunc
+//
+// Adapter to use a function as ValueFormatter.
+type ValueFormatterFunc func(v any) string
+
+// FormatValue
+//
+// See ValueFormatter.
+func (fn ValueFormatterFunc) FormatValue(v any) string {
+	return fn(v)
+}
+
+// #####################################################################################################################
+// VALUE FORMAT
+// #####################################################################################################################
+
+// ValueFormat
+//
+// Default implementation of ValueFormatter -- formats values in Go-syntax (like `%#v`) with limits:
+//   - strings are quoted and truncated to MaxStrLen runes -- e.g. `"abc"... (997 more)`;
+//   - slices, arrays and maps are truncated to MaxItems elements -- e.g. `[]int{1, 2, ... (98 more)}`;
+//   - nested values deeper than MaxDepth are collapsed -- e.g. `main.User{...}`;
+//   - time.Time is formatted with TimeLayout;
+//   - []byte is shown as a string, if it is a valid UTF-8 text, or as bytes otherwise;
+//   - errors and fmt.Stringer implementations are shown by their messages -- e.g. durations as "2h0m0s".
+//
+// Zero limits mean no limits.
+type ValueFormat struct {
+	MaxStrLen  int
+	MaxItems   int
+	MaxDepth   int
+	TimeLayout string
+}
+
+// DefaultValueFormat
+//
+// Returns a new instance of the default global formatter.
+func DefaultValueFormat() *ValueFormat {
+	return &ValueFormat{
+		MaxStrLen:  256,
+		MaxItems:   32,
+		MaxDepth:   3,
+		TimeLayout: time.RFC3339Nano,
+	}
+}
+
+// FormatValue
+//
+// See ValueFormatter.
+func (f *ValueFormat) FormatValue(v any) string {
+	return f.format(reflect.ValueOf(v), 0)
+}
+
+func (f *ValueFormat) format(rv reflect.Value, depth int) string {
+	if !rv.IsValid() {
+		return "<nil>"
+	}
+
+	if s, ok := f.formatSpecial(rv); ok {
+		return s
+	}
+
+	typ := rv.Type().String()
+
+	switch rv.Kind() {
+	case reflect.String:
+		return f.formatStr(rv.String())
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprint(rv.Complex())
+	case reflect.Interface:
+		if rv.IsNil() {
+			return "<nil>"
+		}
+		return f.format(rv.Elem(), depth)
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "(" + typ + ")(nil)"
+		}
+		if f.isDeep(depth) {
+			return "&" + typ[1:] + "{...}"
+		}
+		return "&" + f.format(rv.Elem(), depth+1)
+	case reflect.Slice:
+		if rv.IsNil() {
+			return typ + "(nil)"
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return f.formatBytes(typ, rv.Bytes())
+		}
+		return f.formatList(typ, rv, depth)
+	case reflect.Array:
+		return f.formatList(typ, rv, depth)
+	case reflect.Map:
+		if rv.IsNil() {
+			return typ + "(nil)"
+		}
+		return f.formatMap(typ, rv, depth)
+	case reflect.Struct:
+		return f.formatStruct(typ, rv, depth)
+	default:
+		// chan, func, unsafe pointer
+		if rv.IsNil() {
+			return "(" + typ + ")(nil)"
+		}
+		return fmt.Sprintf("(%s)(%#x)", typ, rv.Pointer())
+	}
+}
+
+func (f *ValueFormat) formatSpecial(rv reflect.Value) (s string, ok bool) {
+	if !rv.CanInterface() {
+		return "", false
+	}
+	if (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) && rv.IsNil() {
+		return "", false
+	}
+
+	// methods of foreign types may panic -- it must not break the message
+	defer func() {
+		if r := recover(); r != nil {
+			s, ok = "", false
+		}
+	}()
+
+	switch tv := rv.Interface().(type) {
+	case time.Time:
+		return tv.Format(ternary[string](f.TimeLayout != "", f.TimeLayout, time.RFC3339Nano)), true
+	case error:
+		return fmt.Sprintf("%T(%s)", tv, f.formatStr(tv.Error())), true
+	case fmt.Stringer:
+		return f.truncate(tv.String()), true
+	default:
+		return "", false
+	}
+}
+
+// fmtDepthLimit -- limit of depth, when MaxDepth is not set, to stop on cyclic references.
+const fmtDepthLimit = 32
+
+func (f *ValueFormat) isDeep(depth int) bool {
+	return depth >= ternary[int](f.MaxDepth > 0, f.MaxDepth, fmtDepthLimit)
+}
+
+func (f *ValueFormat) truncate(s string) string {
+	if f.MaxStrLen <= 0 || utf8.RuneCountInString(s) <= f.MaxStrLen {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:f.MaxStrLen]) + fmt.Sprintf("... (%d more)", len(runes)-f.MaxStrLen)
+}
+
+func (f *ValueFormat) formatStr(s string) string {
+	if f.MaxStrLen <= 0 || utf8.RuneCountInString(s) <= f.MaxStrLen {
+		return strconv.Quote(s)
+	}
+	runes := []rune(s)
+	return strconv.Quote(string(runes[:f.MaxStrLen])) + fmt.Sprintf("... (%d more)", len(runes)-f.MaxStrLen)
+}
+
+func (f *ValueFormat) formatBytes(typ string, bs []byte) string {
+	more := ""
+	if f.MaxStrLen > 0 && len(bs) > f.MaxStrLen {
+		more = fmt.Sprintf("... (%d more)", len(bs)-f.MaxStrLen)
+		bs = bs[:f.MaxStrLen]
+	}
+
+	if utf8.Valid(bs) {
+		return typ + "(" + strconv.Quote(string(bs)) + more + ")"
+	}
+
+	items := make([]string, 0, len(bs)+1)
+	for _, b := range bs {
+		items = append(items, fmt.Sprintf("%#x", b))
+	}
+	if more != "" {
+		items = append(items, more)
+	}
+	return typ + "{" + strings.Join(items, ", ") + "}"
+}
+
+func (f *ValueFormat) formatList(typ string, rv reflect.Value, depth int) string {
+	if f.isDeep(depth) {
+		return typ + "{...}"
+	}
+
+	n := rv.Len()
+	shown := ternary[int](f.MaxItems > 0 && n > f.MaxItems, f.MaxItems, n)
+
+	items := make([]string, 0, shown+1)
+	for i := 0; i < shown; i++ {
+		items = append(items, f.format(rv.Index(i), depth+1))
+	}
+	if shown < n {
+		items = append(items, fmt.Sprintf("... (%d more)", n-shown))
+	}
+	return typ + "{" + strings.Join(items, ", ") + "}"
+}
+
+func (f *ValueFormat) formatMap(typ string, rv reflect.Value, depth int) string {
+	if f.isDeep(depth) {
+		return typ + "{...}"
+	}
+
+	keys := rv.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return fmtMapKeyLess(keys[i], keys[j]) })
+
+	n := len(keys)
+	shown := ternary[int](f.MaxItems > 0 && n > f.MaxItems, f.MaxItems, n)
+
+	items := make([]string, 0, shown+1)
+	for _, k := range keys[:shown] {
+		items = append(items, f.format(k, depth+1)+":"+f.format(rv.MapIndex(k), depth+1))
+	}
+	if shown < n {
+		items = append(items, fmt.Sprintf("... (%d more)", n-shown))
+	}
+	return typ + "{" + strings.Join(items, ", ") + "}"
+}
+
+func (f *ValueFormat) formatStruct(typ string, rv reflect.Value, depth int) string {
+	if f.isDeep(depth) {
+		return typ + "{...}"
+	}
+
+	items := make([]string, 0, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		items = append(items, rv.Type().Field(i).Name+":"+f.format(rv.Field(i), depth+1))
+	}
+	return typ + "{" + strings.Join(items, ", ") + "}"
+}
+
+// fmtMapKeyLess
+//
+// Orders map keys to show maps in a stable way: basic kinds by their values, others by their default formatting.
+func fmtMapKeyLess(a, b reflect.Value) bool {
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// #####################################################################################################################
+// GLOBAL
+// #####################################################################################################################
+
+var valueFormatter = struct {
+	mu sync.RWMutex
+	f  ValueFormatter
+}{
+	f: DefaultValueFormat(),
+}
+
+// SetValueFormatter
+//
+// Sets the global formatter of values in messages. Nil restores the default one -- see DefaultValueFormat.
+func SetValueFormatter(f ValueFormatter) {
+	valueFormatter.mu.Lock()
+	defer valueFormatter.mu.Unlock()
+	valueFormatter.f = ternary[ValueFormatter](f != nil, f, DefaultValueFormat())
+}
+
+func globalValueFormatter() ValueFormatter {
+	valueFormatter.mu.RLock()
+	defer valueFormatter.mu.RUnlock()
+	return valueFormatter.f
+}
+
+// errFormatted
+//
+// Re-renders messages of structured errors with the formatter. Other errors are returned as is.
+func errFormatted(err error, f ValueFormatter) error {
+	switch e := err.(type) {
+	case *AssertionError:
+		return e.withFormatter(f)
+	case *sentinelError:
+		return e.withErr(errFormatted(e.err, f))
+	case ValidationErrors:
+		es := make(ValidationErrors, 0, len(e))
+		for _, ee := range e {
+			es = append(es, errFormatted(ee, f))
+		}
+		return es
+	default:
+		return err
+	}
+}
+
+// #####################################################################################################################
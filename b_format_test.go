@@ -0,0 +1,129 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ValueFormat(t *testing.T) {
+	f := &ValueFormat{MaxStrLen: 5, MaxItems: 3, MaxDepth: 2, TimeLayout: "2006-01-02"}
+
+	t.Run("strings", func(t *testing.T) {
+		tAssert.Equal(t, `"hello"`, f.FormatValue("hello"))
+		tAssert.Equal(t, `"hello"... (7 more)`, f.FormatValue("hello, world"))
+		tAssert.Equal(t, `"привет"`, (&ValueFormat{MaxStrLen: 6}).FormatValue("привет"))
+		tAssert.Equal(t, strings.Repeat("x", 5)+"... (1 more)", f.FormatValue(testStringer("xxxxxx")))
+	})
+
+	t.Run("slices, arrays and maps", func(t *testing.T) {
+		tAssert.Equal(t, "[]int{1, 2, 3}", f.FormatValue([]int{1, 2, 3}))
+		tAssert.Equal(t, "[]int{1, 2, 3, ... (2 more)}", f.FormatValue([]int{1, 2, 3, 4, 5}))
+		tAssert.Equal(t, "[4]int{1, 2, 3, ... (1 more)}", f.FormatValue([4]int{1, 2, 3, 4}))
+		tAssert.Equal(t, "[]int(nil)", f.FormatValue([]int(nil)))
+		tAssert.Equal(t, `map[int]string{1:"a", 2:"b", 10:"c", ... (1 more)}`, f.FormatValue(map[int]string{10: "c", 2: "b", 1: "a", 11: "d"}))
+		tAssert.Equal(t, "map[string]int(nil)", f.FormatValue(map[string]int(nil)))
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		type inner struct{ V []int }
+		type outer struct {
+			In  inner
+			Ptr *inner
+		}
+		v := outer{In: inner{V: []int{1}}, Ptr: &inner{}}
+		tAssert.Equal(t, "assert.outer{In:assert.inner{V:[]int{...}}, Ptr:&assert.inner{...}}", f.FormatValue(v))
+		tAssert.Equal(t, "[][]int{[]int{1}, []int{}}", f.FormatValue([][]int{{1}, {}}))
+		tAssert.Equal(t, "(*assert.inner)(nil)", f.FormatValue((*inner)(nil)))
+
+		type cyclic struct{ Next *cyclic }
+		c := &cyclic{}
+		c.Next = c
+		tAssert.NotPanics(t, func() { (&ValueFormat{}).FormatValue(c) })
+	})
+
+	t.Run("special types", func(t *testing.T) {
+		tAssert.Equal(t, "2024-05-06", f.FormatValue(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)))
+		tAssert.Equal(t, "2024-05-06T07:08:09Z", (&ValueFormat{}).FormatValue(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)))
+		tAssert.Equal(t, "1h0m0s", (&ValueFormat{}).FormatValue(time.Hour))
+		tAssert.Equal(t, `[]uint8("abc")`, f.FormatValue([]byte("abc")))
+		tAssert.Equal(t, `[]uint8("hello"... (1 more))`, f.FormatValue([]byte("hello!")))
+		tAssert.Equal(t, "[]uint8{0xff, 0xfe}", f.FormatValue([]byte{0xff, 0xfe}))
+		tAssert.Equal(t, `*errors.errorString("oops")`, f.FormatValue(errors.New("oops")))
+		tAssert.Equal(t, "(*assert.testStringerPtr)(nil)", f.FormatValue((*testStringerPtr)(nil)))
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		tAssert.Equal(t, "42", f.FormatValue(uint8(42)))
+		tAssert.Equal(t, "-7", f.FormatValue(int64(-7)))
+		tAssert.Equal(t, "0.1", f.FormatValue(float32(0.1)))
+		tAssert.Equal(t, "(1+2i)", f.FormatValue(1+2i))
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		long := strings.Repeat("x", 1000)
+		tAssert.Equal(t, `"`+long+`"`, (&ValueFormat{}).FormatValue(long))
+	})
+}
+
+func Test_ValueFormatter(t *testing.T) {
+	t.Cleanup(func() { SetValueFormatter(nil) })
+
+	long := strings.Repeat("x", 300)
+
+	t.Run("default", func(t *testing.T) {
+		err := Str().LenMax(5).Check(long)
+		tAssert.Equal(t, `length of "`+long[:256]+`"... (44 more) expects to be less or equal to 5, got 300`, err.Error())
+	})
+
+	t.Run("global", func(t *testing.T) {
+		SetValueFormatter(ValueFormatterFunc(func(v any) string { return fmt.Sprintf("<%v>", v) }))
+		tAssert.Equal(t, "value expects to be in range [<1>, <5>], got <7>", Num[int]().InRange(1, 5).Check(7).Error())
+		SetValueFormatter(nil)
+		tAssert.Equal(t, "value expects to be in range [1, 5], got 7", Num[int]().InRange(1, 5).Check(7).Error())
+	})
+
+	t.Run("chain", func(t *testing.T) {
+		fn := ValueFormatterFunc(func(v any) string { return fmt.Sprintf("<%v>", v) })
+
+		err := Num[int]().Formatter(fn).InRange(1, 5).Check(7)
+		tAssert.Equal(t, "value expects to be in range [<1>, <5>], got <7>", err.Error())
+
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "value expects to be in range [<1>, <5>], got <7>", ae.LocalizedMsg(LocaleEn))
+
+		err = Num[int]().Formatter(fn).Named("n").InRange(1, 5, "{path} = {value}").Check(7)
+		tAssert.Equal(t, "n = <7>", err.Error())
+
+		err = Num[int]().Formatter(fn).Custom(func(v int) error { return errors.New("bad") }).Check(7, "{value} is bad")
+		tAssert.Equal(t, "<7> is bad", err.Error())
+
+		err = Num[int]().Formatter(fn).Sensitive().Eq(1).Check(7)
+		tAssert.Equal(t, "value expects to be equal to <1>, got <[redacted]>", err.Error())
+
+		// nested chain keeps own formatter
+		inner := Num[int]().Formatter(ValueFormatterFunc(func(v any) string { return "?" })).Eq(1)
+		err = Num[int]().Formatter(fn).Custom(func(v int) error { return inner.Check(v) }).Check(7)
+		tAssert.Equal(t, "value expects to be equal to ?, got ?", err.Error())
+
+		tAssert.Equal(t, "value expects to be in range [1, 5], got 7", Num[int]().Formatter(nil).InRange(1, 5).Check(7).Error())
+	})
+}
+
+type testStringer string
+
+func (s testStringer) String() string {
+	return string(s)
+}
+
+type testStringerPtr struct {
+	s string
+}
+
+func (s *testStringerPtr) String() string {
+	return s.s
+}
@@ -0,0 +1,13 @@
+package assert
+
+// Validator
+//
+// Read-only validator -- the frozen snapshot of an assertion chain, see Freeze.
+//
+// Has only result methods (Check, CheckAll, Validate, Must, etc.), so no rules can be added to it.
+// Safe for concurrent use -- e.g. as a package-level variable, if custom rules of the chain are safe as well.
+//
+// Implements Checker, so it can be used in logical combinators and conditional rules.
+type Validator[T any] struct {
+	*assert[T]
+}
@@ -0,0 +1,139 @@
+package assert
+
+import (
+	"context"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_Freeze(t *testing.T) {
+	t.Run("snapshot", func(t *testing.T) {
+		a := Str().Named("name").RunesMin(2)
+		v := a.Freeze()
+
+		a.RunesMax(3)
+		tAssert.NoError(t, v.Check("abcd"))
+		tAssert.Error(t, a.Check("abcd"))
+		tAssert.Equal(t, "name: runes count of \"a\" expects to be greater or equal to 2, got 1", v.Check("a").Error())
+
+		tAssert.Len(t, v.CheckAll("a"), 1)
+		tAssert.Error(t, v.CheckAllErr("a"))
+		tAssert.True(t, v.Validate("ab").Valid())
+		tAssert.Panics(t, func() { v.Must("a") })
+		tAssert.Equal(t, "ab", v.MustGet("ab"))
+
+		// as Checker
+		tAssert.NoError(t, Str().AnyOf(Str().Empty(), v).Check(""))
+		tAssert.Error(t, Str().AnyOf(Str().Empty(), v).Check("a"))
+	})
+
+	t.Run("sub-chains", func(t *testing.T) {
+		alt := Str().Empty()
+		sub := Str().LenMin(2)
+		cond := Str().Empty()
+		v := Str().
+			AnyOf(alt, Str().Numeric()).
+			When(func(v string) bool { return v != "" }, sub).
+			UnlessPasses(cond, Str().LenMax(5)).
+			Freeze()
+
+		// changes of sub-chains do not affect the snapshot
+		alt.LenMin(100)
+		sub.LenMin(100)
+		cond.LenMin(100)
+		tAssert.NoError(t, v.Check(""))
+		tAssert.NoError(t, v.Check("12"))
+		tAssert.Error(t, v.Check("1"))
+		tAssert.Error(t, v.Check("123456"))
+
+		// other checkers are used as is
+		var fail bool
+		checker := testChecker[string](func(ctx context.Context, v string) error {
+			return ternary[error](fail, fmt.Errorf("fail"), nil)
+		})
+		w := Str().AnyOf(checker).Freeze()
+		tAssert.NoError(t, w.Check(""))
+		fail = true
+		tAssert.Error(t, w.Check(""))
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		a := Str().RunesMin(2).Timeout(time.Second)
+		c := a.Clone().RunesMax(3).Warn()
+
+		tAssert.NoError(t, a.Check("abcd"))
+		tAssert.NoError(t, c.Check("abcd"))
+		tAssert.True(t, c.Validate("abcd").HasWarnings())
+
+		// adjusting the last cloned rule does not affect the original
+		d := a.Clone().Warn()
+		tAssert.NoError(t, d.Check("a"))
+		tAssert.Error(t, a.Check("a"))
+
+		e := a.Clone().Groups("create")
+		tAssert.NoError(t, e.CheckCtx(WithGroups(context.Background(), "update"), "a"))
+		tAssert.Error(t, a.CheckCtx(WithGroups(context.Background(), "update"), "a"))
+
+		// settings of the chain are cloned
+		f := Num[int]().Named("age").GreaterEq(18).Clone()
+		tAssert.Equal(t, "age: value expects to be greater or equal to 18, got 1", f.Check(1).Error())
+
+		// all types
+		tAssert.Error(t, Any[int]().Custom(func(v int) error { return fmt.Errorf("x") }).Clone().Check(1))
+		tAssert.Error(t, Bool().True().Clone().Check(false))
+		tAssert.Error(t, Cmp[int]().Eq(1).Clone().Check(2))
+		tAssert.Error(t, SliceAny[[]int]().NotEmpty().Clone().Check(nil))
+		tAssert.Error(t, SliceCmp[[]int]().Contains(1).Clone().Check(nil))
+		tAssert.Error(t, Time().NotZero().Clone().Check(time.Time{}))
+		tAssert.Error(t, TimeDur().NotZero().Clone().Check(0))
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		shared := Str().Named("name").
+			NotEmpty().Bail().
+			Word().
+			RunesInRange(2, 8).
+			RunesMin(4).Warn().
+			Freeze()
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 32; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					v := fmt.Sprintf("name%d", i)
+					tAssert.Error(t, shared.Check(v))
+					tAssert.Len(t, shared.CheckAll(v+v), 2)
+					tAssert.True(t, shared.Validate("Bob").HasWarnings())
+					tAssert.NoError(t, shared.CheckCtx(context.Background(), "Alice"))
+
+					// extending of clones of the shared chain
+					ext := Str().AllOf(shared).Clone().RunesMax(4)
+					tAssert.Error(t, ext.Check("Alice"))
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+
+	t.Run("concurrent cloning", func(t *testing.T) {
+		base := Str().RunesMin(2)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 32; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				c := base.Clone().RunesMax(i).Named(fmt.Sprintf("v%d", i)).Parallel(2)
+				tAssert.Equal(t, i >= 5, c.Check("hello") == nil)
+			}(i)
+		}
+		wg.Wait()
+
+		tAssert.NoError(t, base.Check("hello, world"))
+	})
+}
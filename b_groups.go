@@ -0,0 +1,57 @@
+package assert
+
+import "context"
+
+type groupsCtxKey struct{}
+
+// WithGroups
+//
+// Returns the copy of the context, that selects groups of rules (see Groups) for context-aware result methods
+// (CheckCtx, CheckAllCtx, ValidateCtx, MustCtx, etc.) -- e.g. different rules for create and update scenarios:
+//
+//	a := assert.Str().NotEmpty().Groups("create").RunesMax(32)
+//
+//	a.CheckCtx(assert.WithGroups(ctx, "create"), name) // NotEmpty and RunesMax
+//	a.CheckCtx(assert.WithGroups(ctx, "update"), name) // RunesMax only
+//
+// Rules without groups are always run. If no groups selected, all rules are run.
+// Groups selected by the parent context are replaced.
+//
+// Panics, if no groups given or any of them is empty.
+func WithGroups(ctx context.Context, groups ...string) context.Context {
+	if len(groups) == 0 {
+		panic("WithGroups expects at least one group")
+	}
+	for _, g := range groups {
+		if g == "" {
+			panic("WithGroups expects not empty groups")
+		}
+	}
+
+	return context.WithValue(ctx, groupsCtxKey{}, append([]string(nil), groups...))
+}
+
+// selectedGroups
+//
+// Returns groups selected via WithGroups or nil, if none.
+func selectedGroups(ctx context.Context) []string {
+	groups, _ := ctx.Value(groupsCtxKey{}).([]string)
+	return groups
+}
+
+// isSelected
+//
+// Returns true, if the rule has no groups or belongs to any of the selected groups, or no groups selected.
+func (r *rule[T]) isSelected(selected []string) bool {
+	if len(r.groups) == 0 || len(selected) == 0 {
+		return true
+	}
+	for _, g := range r.groups {
+		for _, s := range selected {
+			if g == s {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package assert
+
+import (
+	"context"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Groups(t *testing.T) {
+	ctx := context.Background()
+	ctxCreate := WithGroups(ctx, "create")
+	ctxUpdate := WithGroups(ctx, "update")
+
+	fnName := func() *AString {
+		return Str().Named("name").
+			NotEmpty().Groups("create").
+			RunesMax(3).
+			PrefixEq("x").Groups("create", "import")
+	}
+
+	t.Run("Check", func(t *testing.T) {
+		a := fnName()
+		tAssert.Equal(t, `name: value expects to be not equal to "", got ""`, a.CheckCtx(ctxCreate, "").Error())
+		tAssert.NoError(t, a.CheckCtx(ctxUpdate, ""))
+		tAssert.Error(t, a.CheckCtx(ctxUpdate, "abcd"))
+		tAssert.NoError(t, a.CheckCtx(ctxUpdate, "abc"))
+		tAssert.Error(t, a.CheckCtx(ctxCreate, "abc"))
+		tAssert.Error(t, a.CheckCtx(WithGroups(ctx, "import"), "abc"))
+		tAssert.NoError(t, a.CheckCtx(ctxCreate, "xab"))
+
+		// no groups selected
+		tAssert.Error(t, a.Check(""))
+		tAssert.Error(t, a.CheckCtx(ctx, "abc"))
+
+		// selection is replaced
+		tAssert.NoError(t, a.CheckCtx(WithGroups(ctxCreate, "update"), ""))
+
+		// any of selected groups
+		tAssert.Error(t, a.CheckCtx(WithGroups(ctx, "update", "create"), ""))
+	})
+
+	t.Run("CheckAll, Validate, Must", func(t *testing.T) {
+		a := fnName()
+		tAssert.Len(t, a.CheckAllCtx(ctxCreate, ""), 2)
+		tAssert.Len(t, a.CheckAllCtx(ctxUpdate, ""), 0)
+		tAssert.Len(t, a.CheckAllCtx(ctx, ""), 2)
+		tAssert.NoError(t, a.CheckAllErrCtx(ctxUpdate, ""))
+		tAssert.True(t, a.ValidateCtx(ctxUpdate, "").Valid())
+		tAssert.False(t, a.ValidateCtx(ctxCreate, "").Valid())
+		tAssert.NotPanics(t, func() { a.MustCtx(ctxUpdate, "") })
+		tAssert.NotPanics(t, func() { a.MustAllCtx(ctxUpdate, "") })
+		tAssert.Panics(t, func() { a.MustAllCtx(ctxCreate, "") })
+
+		tAssert.Equal(t, a.CheckAllCtx(ctxCreate, "abcd"), a.Parallel(2).CheckAllCtx(ctxCreate, "abcd"))
+		tAssert.Len(t, a.CheckAllCtx(ctxUpdate, "abcd"), 1)
+		tAssert.Len(t, a.CheckAllCtx(ctxCreate, "abcd"), 2)
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		a := Str().RunesMin(8).Groups("create").Warn()
+		tAssert.True(t, a.ValidateCtx(ctxCreate, "a").HasWarnings())
+		tAssert.False(t, a.ValidateCtx(ctxUpdate, "a").HasWarnings())
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		tAssert.Panics(t, func() { Str().Groups("create") })
+		tAssert.Panics(t, func() { Str().NotEmpty().Groups() })
+		tAssert.Panics(t, func() { Str().NotEmpty().Groups("") })
+		tAssert.Panics(t, func() { WithGroups(ctx) })
+		tAssert.Panics(t, func() { WithGroups(ctx, "create", "") })
+	})
+}
@@ -1,9 +1,6 @@
 package assert
 
-import (
-	"errors"
-	"fmt"
-)
+import "errors"
 
 func ternary[T any](condition bool, rTrue T, rFalse T) T {
 	if condition {
@@ -12,30 +9,117 @@ func ternary[T any](condition bool, rTrue T, rFalse T) T {
 	return rFalse
 }
 
+// fmtVal
+//
+// Formats the value with the global formatter -- see SetValueFormatter.
 func fmtVal[T any](v T) string {
-	switch tv := any(v).(type) {
-	case string:
-		// raw strings better to show in quotes
-		return fmt.Sprintf("%q", tv)
-	case fmt.Stringer:
-		// e.g. time.Duration.String() provides better format -- better than %v
-		return fmt.Sprintf("%s", tv)
+	return globalValueFormatter().FormatValue(v)
+}
+
+func customMsg(customErrMsg []string) string {
+	if len(customErrMsg) > 0 {
+		return customErrMsg[0]
+	}
+	return ""
+}
+
+// mkCustomErr
+//
+// Replaces the message of the given error with the custom message, if provided.
+// Returns nil, if no custom message provided.
+//
+// The custom message is a template -- see MessageTemplates.
+// Structured errors keep their details -- only the message is replaced.
+func mkCustomErr(err error, args MessageArgs, customErrMsg []string) error {
+	msg := customMsg(customErrMsg)
+	if msg == "" {
+		return nil
+	}
+	switch e := err.(type) {
+	case *AssertionError:
+		return e.withCustomTpl(msg)
+	case *sentinelError:
+		return e.withErr(mkCustomErr(e.err, args, customErrMsg))
 	default:
-		return fmt.Sprintf("%#v", tv)
+		return errors.New(renderMsgTemplate(msg, args, localePlural(Locale())))
+	}
+}
+
+// joinErrWrappers
+//
+// Returns the wrapper, that applies both wrappers one by one. Nil wrappers are skipped.
+func joinErrWrappers(first, second func(err error) error) func(err error) error {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+	return func(err error) error {
+		return second(first(err))
 	}
 }
 
-func mkCustomErr(customErrMsg []string) error {
-	if len(customErrMsg) > 0 && customErrMsg[0] != "" {
-		return errors.New(customErrMsg[0])
+// mkCheckErr
+//
+// Returns the error of the failed rule with the default message in the current locale (see SetLocale).
+//
+// The custom message is a template -- see MessageTemplates.
+//
+// Parameters are copied, since maps of parameters of rules are shared by all checks of the chain.
+func mkCheckErr(
+	code string,
+	params map[string]any,
+	v any,
+	facts map[string]any,
+	customErrMsg []string,
+) error {
+	err := &AssertionError{
+		Rule:   code,
+		Params: copyMap(params),
+		Value:  v,
+		Facts:  facts,
+	}
+	err.DefaultMsg = translateMsg(Locale(), code, err.msgArgs())
+	if msg := customMsg(customErrMsg); msg != "" {
+		err.setCustomTpl(msg)
 	}
-	return nil
+	return err
 }
 
-func mkCheckErr(defaultMsg string, customErrMsg []string) error {
-	if err := mkCustomErr(customErrMsg); err != nil {
-		return err
+// copyMap
+//
+// Returns the shallow copy of the map. Nil for nil.
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
 	}
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
 
-	return errors.New(defaultMsg)
+// inSlice
+//
+// Returns true, if the slice contains the value.
+func inSlice[T comparable](s []T, v T) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// errRule
+//
+// Returns the code of the rule of the error -- see AssertionError.Rule. Empty, if the error is not an AssertionError.
+func errRule(err error) string {
+	var ae *AssertionError
+	if errors.As(err, &ae) {
+		return ae.Rule
+	}
+	return ""
 }
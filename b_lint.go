@@ -0,0 +1,756 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Kinds of issues found by Lint.
+const (
+	// LintContradiction -- rules, that can't pass together, e.g. `LenMin(10).LenMax(5)`.
+	LintContradiction = "contradiction"
+	// LintEmptySet -- the rule, that never passes, e.g. `In([]string{})` or `InRange(10, 1)`.
+	LintEmptySet = "emptySet"
+	// LintNegativeLen -- the rule with negative length, e.g. `LenMin(-1)`.
+	LintNegativeLen = "negativeLen"
+	// LintRedundant -- the rule, that always passes or is implied by other rules,
+	// e.g. `NotIn([]string{})` or `LenMin(3)` of `LenMin(3).LenMin(5)`.
+	LintRedundant = "redundant"
+)
+
+// LintIssue
+//
+// Issue of the assertion chain found by Lint.
+type LintIssue struct {
+	// Kind -- kind of the issue, see LintContradiction, LintEmptySet, etc.
+	Kind string `json:"kind"`
+	// Rules -- positions of the involved rules in the chain (starting from 1).
+	Rules []int `json:"rules"`
+	// Groups -- groups of rules (see Groups), where the issue is found,
+	// or nil, if the issue is found in rules without groups.
+	Groups []string `json:"groups,omitempty"`
+	// Msg -- description of the issue, e.g. `#1 LenMin(10) contradicts #2 LenMax(5)`.
+	Msg string `json:"msg"`
+}
+
+// String
+//
+// Returns the kind and the description of the issue, e.g. `contradiction: #1 LenMin(10) contradicts #2 LenMax(5)`.
+func (i LintIssue) String() string {
+	s := i.Kind + ": " + i.Msg
+	if len(i.Groups) > 0 {
+		s += " (groups: " + strings.Join(i.Groups, ", ") + ")"
+	}
+	return s
+}
+
+// Lint
+//
+// Analyzes registered rules and returns issues of the chain or nil, if none found --
+// e.g. to catch mistakes in definitions of chains in unit tests:
+//
+//	tAssert.Empty(t, userName.Lint())
+//
+// Finds
+//   - contradictions -- e.g. `LenMin(10).LenMax(5)`, `Eq("a").NotEq("a")`, `Positive().Negative()`
+//   - empty sets -- rules, that never pass, e.g. `In([]string{})`, `InRange(10, 1)`
+//   - negative length bounds -- e.g. `LenMin(-1)`, `RunesMax(-1)`
+//   - redundancies -- rules, that always pass or are implied by other rules,
+//     e.g. `NotIn([]string{})`, `LenMin(3).LenMin(5)`, duplicated rules
+//
+// Only rules with known parameters are analyzed -- custom rules, logical combinators, conditional rules,
+// rules with operands evaluated on each check ("Fn" variants), negated rules (see Not)
+// and warnings (see Warn) are ignored.
+//
+// Rules with groups (see Groups) are analyzed for each group separately along with rules without groups.
+func (a *assert[T]) Lint() []LintIssue {
+	rules := make([]lintRule, 0, len(a.rules))
+	for i, r := range a.rules {
+		if r.not || r.severity == SeverityWarning || hasDynamicParams(r.params) {
+			continue
+		}
+		rules = append(rules, lintRule{pos: i + 1, code: r.code, name: r.name, params: r.params, groups: r.groups})
+	}
+
+	return lintScenarios(rules)
+}
+
+// #####################################################################################################################
+// ANALYSIS
+// #####################################################################################################################
+
+// lintRule
+//
+// Rule of the chain for the analysis.
+type lintRule struct {
+	pos    int
+	code   string
+	name   string
+	params map[string]any
+	groups []string
+}
+
+// String
+//
+// Returns the position and the call of the rule, e.g. `#1 LenMin(10)`.
+// Aliases of rules are shown by their names without arguments, e.g. `#1 Positive()`.
+func (r lintRule) String() string {
+	if r.name != "" {
+		return fmt.Sprintf("#%d %s()", r.pos, r.name)
+	}
+	args := make([]string, 0, len(r.params))
+	for _, p := range r.args() {
+		args = append(args, fmtVal(p))
+	}
+	return fmt.Sprintf("#%d %s(%s)", r.pos, r.code, strings.Join(args, ", "))
+}
+
+// lintParamsOrder -- order of parameters in calls of rules.
+var lintParamsOrder = []string{
+	"eq", "notEq", "in", "notIn", "than", "elem", "elems", "substr", "substrs", "pattern", "condition", "count",
+	"min", "max",
+}
+
+// args
+//
+// Returns parameters of the rule in the order of arguments of the rule.
+func (r lintRule) args() []any {
+	args := make([]any, 0, len(r.params))
+	for _, name := range lintParamsOrder {
+		if p, ok := r.params[name]; ok {
+			args = append(args, p)
+		}
+	}
+	return args
+}
+
+// lintScenarios
+//
+// Analyzes rules for each group separately (see Groups) or all together, if there are no groups.
+func lintScenarios(rules []lintRule) []LintIssue {
+	var groups []string
+	grouped := make(map[int]bool, len(rules))
+	for _, r := range rules {
+		for _, g := range r.groups {
+			if !inSlice(groups, g) {
+				groups = append(groups, g)
+			}
+			grouped[r.pos] = true
+		}
+	}
+	if len(groups) == 0 {
+		return lintSorted(lintRules(rules))
+	}
+	sort.Strings(groups)
+
+	var issues []LintIssue
+	index := make(map[string]int)
+	for _, g := range groups {
+		scenario := make([]lintRule, 0, len(rules))
+		for _, r := range rules {
+			if len(r.groups) == 0 || inSlice(r.groups, g) {
+				scenario = append(scenario, r)
+			}
+		}
+
+		for _, issue := range lintRules(scenario) {
+			key := fmt.Sprint(issue.Kind, issue.Rules, issue.Msg)
+			i, ok := index[key]
+			if !ok {
+				i = len(issues)
+				index[key] = i
+				issues = append(issues, issue)
+			}
+			for _, pos := range issue.Rules {
+				if grouped[pos] {
+					issues[i].Groups = append(issues[i].Groups, g)
+					break
+				}
+			}
+		}
+	}
+	return lintSorted(issues)
+}
+
+// lintSorted
+//
+// Sorts issues by positions of their rules.
+func lintSorted(issues []LintIssue) []LintIssue {
+	sort.SliceStable(issues, func(i, j int) bool {
+		ri, rj := issues[i].Rules, issues[j].Rules
+		for k := 0; k < len(ri) && k < len(rj); k++ {
+			if ri[k] != rj[k] {
+				return ri[k] < rj[k]
+			}
+		}
+		return len(ri) < len(rj)
+	})
+	return issues
+}
+
+// linter
+//
+// Collects issues of rules of a single scenario.
+type linter struct {
+	issues    []LintIssue
+	redundant map[int]bool
+}
+
+// lintRules
+//
+// Analyzes rules of a single scenario.
+func lintRules(rules []lintRule) []LintIssue {
+	l := &linter{redundant: make(map[int]bool)}
+
+	valid := make([]lintRule, 0, len(rules))
+	for _, r := range rules {
+		if l.lintRule(r) {
+			valid = append(valid, r)
+		}
+	}
+	valid = l.lintDuplicates(valid)
+	l.lintOpposites(valid)
+	l.lintEq(valid)
+	l.lintBounds(valid)
+
+	return l.issues
+}
+
+// report
+//
+// Adds the issue of the given rules.
+func (l *linter) report(kind string, msg string, rules ...lintRule) {
+	positions := make([]int, 0, len(rules))
+	for _, r := range rules {
+		if !inSlice(positions, r.pos) {
+			positions = append(positions, r.pos)
+		}
+	}
+	sort.Ints(positions)
+	l.issues = append(l.issues, LintIssue{Kind: kind, Rules: positions, Msg: msg})
+}
+
+// reportRedundant
+//
+// Adds the issue of the redundant rule implied by the given rules.
+func (l *linter) reportRedundant(r lintRule, by ...lintRule) {
+	l.redundant[r.pos] = true
+
+	implied := make([]string, 0, len(by))
+	for _, b := range by {
+		implied = append(implied, b.String())
+	}
+	l.report(
+		LintRedundant,
+		fmt.Sprintf("%s is redundant -- implied by %s", r, strings.Join(implied, ", ")),
+		append([]lintRule{r}, by...)...,
+	)
+}
+
+// reportContradiction
+//
+// Adds the issue of two rules, that can't pass together.
+func (l *linter) reportContradiction(r1, r2 lintRule) {
+	if r2.pos < r1.pos {
+		r1, r2 = r2, r1
+	}
+	for _, issue := range l.issues {
+		if issue.Kind == LintContradiction && issue.Rules[0] == r1.pos && issue.Rules[1] == r2.pos {
+			return
+		}
+	}
+	l.report(LintContradiction, fmt.Sprintf("%s contradicts %s", r1, r2), r1, r2)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Single Rules
+// ---------------------------------------------------------------------------------------------------------------------
+
+// lintLenCodes -- codes of rules with parameters of length.
+var lintLenCodes = map[string]bool{
+	CodeLenEq: true, CodeLenNotEq: true, CodeLenMin: true, CodeLenMax: true,
+	CodeLenInRange: true, CodeLenNotInRange: true,
+	CodeRunesEq: true, CodeRunesNotEq: true, CodeRunesMin: true, CodeRunesMax: true,
+	CodeRunesInRange: true, CodeRunesNotInRange: true,
+	CodeUniquesLenEq: true, CodeUniquesLenNotEq: true, CodeUniquesLenMin: true, CodeUniquesLenMax: true,
+	CodeUniquesLenInRange: true, CodeUniquesLenNotInRange: true,
+}
+
+// lintRule
+//
+// Analyzes the rule itself. Returns false, if the rule has an issue and should not be analyzed with other rules.
+func (l *linter) lintRule(r lintRule) bool {
+	if lintLenCodes[r.code] {
+		for _, p := range r.args() {
+			if n, ok := p.(int); ok && n < 0 {
+				l.report(LintNegativeLen, fmt.Sprintf("%s has negative length", r), r)
+				return false
+			}
+		}
+	}
+
+	switch r.code {
+	case CodeIn, CodePrefixIn, CodeSuffixIn:
+		if lintLen(r.params["in"]) == 0 {
+			l.report(LintEmptySet, fmt.Sprintf("%s never passes -- no elements provided", r), r)
+			return false
+		}
+	case CodeContainsAny:
+		if lintLen(r.params["elems"]) == 0 {
+			l.report(LintEmptySet, fmt.Sprintf("%s never passes -- no elements provided", r), r)
+			return false
+		}
+	case CodePrefixNotEq, CodeSuffixNotEq:
+		if r.params["notEq"] == "" {
+			l.report(LintEmptySet, fmt.Sprintf("%s never passes -- empty string provided", r), r)
+			return false
+		}
+	case CodeNotContainsStr:
+		if r.params["substr"] == "" {
+			l.report(LintEmptySet, fmt.Sprintf("%s never passes -- empty string provided", r), r)
+			return false
+		}
+	case CodeInRange, CodeLenInRange, CodeRunesInRange, CodeUniquesLenInRange:
+		if c, ok := lintCompare(r.params["min"], r.params["max"]); ok && c > 0 {
+			l.report(LintEmptySet, fmt.Sprintf("%s never passes -- min is greater than max", r), r)
+			return false
+		}
+
+	case CodeNotIn, CodePrefixNotIn, CodeSuffixNotIn:
+		if lintLen(r.params["notIn"]) == 0 {
+			l.report(LintRedundant, fmt.Sprintf("%s always passes -- no elements provided", r), r)
+			return false
+		}
+	case CodeLessAny, CodeLessEqAny, CodeLessEach, CodeLessEqEach,
+		CodeGreaterAny, CodeGreaterEqAny, CodeGreaterEach, CodeGreaterEqEach,
+		CodeContainsEach, CodeContainsNone:
+		if lintLen(r.params["elems"]) == 0 {
+			l.report(LintRedundant, fmt.Sprintf("%s always passes -- no elements provided", r), r)
+			return false
+		}
+	case CodeContainsStrAny, CodeContainsStrEach, CodeContainsStrNone:
+		if lintLen(r.params["substrs"]) == 0 {
+			l.report(LintRedundant, fmt.Sprintf("%s always passes -- no elements provided", r), r)
+			return false
+		}
+	case CodePrefixEq, CodeSuffixEq:
+		if r.params["eq"] == "" {
+			l.report(LintRedundant, fmt.Sprintf("%s always passes -- empty string provided", r), r)
+			return false
+		}
+	case CodeContainsStr:
+		if r.params["substr"] == "" {
+			l.report(LintRedundant, fmt.Sprintf("%s always passes -- empty string provided", r), r)
+			return false
+		}
+	case CodeNotInRange, CodeLenNotInRange, CodeRunesNotInRange, CodeUniquesLenNotInRange:
+		if c, ok := lintCompare(r.params["min"], r.params["max"]); ok && c > 0 {
+			l.report(LintRedundant, fmt.Sprintf("%s always passes -- min is greater than max", r), r)
+			return false
+		}
+	}
+	return true
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Duplicates
+// ---------------------------------------------------------------------------------------------------------------------
+
+// lintOpaqueCodes -- codes of rules, that can't be compared by parameters.
+var lintOpaqueCodes = map[string]bool{
+	CodeCustom:            true,
+	CodeCustomElementAny:  true,
+	CodeCustomElementEach: true,
+	CodeCustomElementNone: true,
+	CodeAnyOf:             true,
+	CodeOneOf:             true,
+	CodeAllOf:             true,
+	CodeNoneOf:            true,
+	CodeWhen:              true,
+	CodeUnless:            true,
+	CodeWhenPasses:        true,
+	CodeUnlessPasses:      true,
+}
+
+// lintDuplicates
+//
+// Reports rules with the same code and parameters as preceding rules. Returns rules without duplicates.
+func (l *linter) lintDuplicates(rules []lintRule) []lintRule {
+	unique := make([]lintRule, 0, len(rules))
+	for _, r := range rules {
+		dup := -1
+		if !lintOpaqueCodes[r.code] {
+			for i, u := range unique {
+				if u.code == r.code && lintSameArgs(u, r) && lintSameArgs(r, u) {
+					dup = i
+					break
+				}
+			}
+		}
+		if dup >= 0 {
+			l.redundant[r.pos] = true
+			l.report(LintRedundant, fmt.Sprintf("%s is redundant -- duplicates %s", r, unique[dup]), unique[dup], r)
+			continue
+		}
+		unique = append(unique, r)
+	}
+	return unique
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Opposites
+// ---------------------------------------------------------------------------------------------------------------------
+
+// lintOpposites -- codes of rules, that can't pass together with the same parameters.
+var lintOppositeCodes = map[string]string{
+	CodeEq:                CodeNotEq,
+	CodeIn:                CodeNotIn,
+	CodeInRange:           CodeNotInRange,
+	CodeLenEq:             CodeLenNotEq,
+	CodeLenInRange:        CodeLenNotInRange,
+	CodeEmpty:             CodeNotEmpty,
+	CodeContains:          CodeNotContains,
+	CodeContainsAny:       CodeContainsNone,
+	CodeUniquesLenEq:      CodeUniquesLenNotEq,
+	CodeUniquesLenInRange: CodeUniquesLenNotInRange,
+	CodePrefixEq:          CodePrefixNotEq,
+	CodePrefixIn:          CodePrefixNotIn,
+	CodeSuffixEq:          CodeSuffixNotEq,
+	CodeSuffixIn:          CodeSuffixNotIn,
+	CodeContainsStr:       CodeNotContainsStr,
+	CodeRunesEq:           CodeRunesNotEq,
+	CodeRunesInRange:      CodeRunesNotInRange,
+}
+
+// lintOpposites
+//
+// Reports rules, that can't pass together with the same parameters, e.g. `Eq("a").NotEq("a")`.
+// Sets of elements are compared regardless of the order -- e.g. `In([]string{"a", "b"}).NotIn([]string{"b", "a"})`,
+// as well as `In([]string{"a"}).NotIn([]string{"a", "b"})`.
+func (l *linter) lintOpposites(rules []lintRule) {
+	for _, r := range rules {
+		opposite, ok := lintOppositeCodes[r.code]
+		if !ok {
+			continue
+		}
+		for _, o := range rules {
+			if o.code == opposite && lintSameArgs(r, o) {
+				l.reportContradiction(r, o)
+			}
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Equality
+// ---------------------------------------------------------------------------------------------------------------------
+
+// lintEq
+//
+// Reports rules contradicting Eq rules or implied by them, e.g. `Eq("a").In([]string{"b"})`.
+func (l *linter) lintEq(rules []lintRule) {
+	for _, e := range rules {
+		if e.code != CodeEq {
+			continue
+		}
+		eq := e.params["eq"]
+		for _, o := range rules {
+			switch o.code {
+			case CodeEq:
+				if e.pos < o.pos && !lintEqual(eq, o.params["eq"]) {
+					l.reportContradiction(e, o)
+				}
+			case CodeNotEq:
+				if !l.redundant[o.pos] && !lintEqual(eq, o.params["notEq"]) {
+					l.reportRedundant(o, e)
+				}
+			case CodeIn:
+				if !lintContains(o.params["in"], eq) {
+					l.reportContradiction(e, o)
+				} else if !l.redundant[o.pos] {
+					l.reportRedundant(o, e)
+				}
+			case CodeNotIn:
+				if lintContains(o.params["notIn"], eq) {
+					l.reportContradiction(e, o)
+				} else if !l.redundant[o.pos] {
+					l.reportRedundant(o, e)
+				}
+			}
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Bounds
+// ---------------------------------------------------------------------------------------------------------------------
+
+// lintBound
+//
+// Lower or upper bound of the checked value or of its length set by a rule.
+type lintBound struct {
+	upper  bool
+	v      any
+	strict bool
+}
+
+// tighter
+//
+// Compares bounds of the same side -- returns positive number, if the bound is tighter than the other one,
+// negative, if it is looser, and zero, if they are equal.
+func (b lintBound) tighter(other lintBound) int {
+	c, _ := lintCompare(b.v, other.v)
+	if b.upper {
+		c = -c
+	}
+	if c != 0 {
+		return c
+	}
+	switch {
+	case b.strict == other.strict:
+		return 0
+	case b.strict:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// lintBoundsDims -- dimensions of bounds, that are analyzed separately.
+var lintBoundsDims = []string{"value", "len", "runes", "uniquesLen"}
+
+// lintRuleBounds
+//
+// Returns the dimension and bounds set by the rule, if any.
+func lintRuleBounds(r lintRule) (dim string, bounds []lintBound) {
+	lower := func(v any, strict bool) lintBound { return lintBound{v: v, strict: strict} }
+	upper := func(v any, strict bool) lintBound { return lintBound{upper: true, v: v, strict: strict} }
+
+	switch r.code {
+	case CodeLess:
+		return "value", []lintBound{upper(r.params["than"], true)}
+	case CodeLessEq:
+		return "value", []lintBound{upper(r.params["than"], false)}
+	case CodeGreater:
+		return "value", []lintBound{lower(r.params["than"], true)}
+	case CodeGreaterEq:
+		return "value", []lintBound{lower(r.params["than"], false)}
+	case CodeEq, CodeLenEq, CodeRunesEq, CodeUniquesLenEq:
+		dim = map[string]string{
+			CodeEq: "value", CodeLenEq: "len", CodeRunesEq: "runes", CodeUniquesLenEq: "uniquesLen",
+		}[r.code]
+		return dim, []lintBound{lower(r.params["eq"], false), upper(r.params["eq"], false)}
+	case CodeInRange, CodeLenInRange, CodeRunesInRange, CodeUniquesLenInRange:
+		dim = map[string]string{
+			CodeInRange: "value", CodeLenInRange: "len", CodeRunesInRange: "runes", CodeUniquesLenInRange: "uniquesLen",
+		}[r.code]
+		return dim, []lintBound{lower(r.params["min"], false), upper(r.params["max"], false)}
+	case CodeLenMin, CodeRunesMin, CodeUniquesLenMin:
+		dim = map[string]string{CodeLenMin: "len", CodeRunesMin: "runes", CodeUniquesLenMin: "uniquesLen"}[r.code]
+		return dim, []lintBound{lower(r.params["min"], false)}
+	case CodeLenMax, CodeRunesMax, CodeUniquesLenMax:
+		dim = map[string]string{CodeLenMax: "len", CodeRunesMax: "runes", CodeUniquesLenMax: "uniquesLen"}[r.code]
+		return dim, []lintBound{upper(r.params["max"], false)}
+	}
+	return "", nil
+}
+
+// lintBounds
+//
+// Reports rules with bounds of the checked value or of its length, that contradict each other,
+// e.g. `LenMin(10).LenMax(5)`, or are implied by other ones, e.g. `LenMin(3).LenMin(5)`.
+func (l *linter) lintBounds(rules []lintRule) {
+	type entry struct {
+		r      lintRule
+		bounds []lintBound
+	}
+	dims := make(map[string][]entry)
+	for _, r := range rules {
+		dim, bounds := lintRuleBounds(r)
+		if dim == "" || !lintOrdered(bounds) {
+			continue
+		}
+		dims[dim] = append(dims[dim], entry{r: r, bounds: bounds})
+	}
+
+	for _, dim := range lintBoundsDims {
+		entries := dims[dim]
+		if len(entries) < 2 {
+			continue
+		}
+
+		// contradiction of the tightest bounds
+		var lo, up *lintBound
+		var loE, upE entry
+		for _, e := range entries {
+			for i, b := range e.bounds {
+				switch {
+				case !b.upper && (lo == nil || b.tighter(*lo) > 0):
+					lo, loE = &e.bounds[i], e
+				case b.upper && (up == nil || b.tighter(*up) > 0):
+					up, upE = &e.bounds[i], e
+				}
+			}
+		}
+		if lo != nil && up != nil && loE.r.pos != upE.r.pos {
+			if c, _ := lintCompare(lo.v, up.v); c > 0 || (c == 0 && (lo.strict || up.strict)) {
+				l.reportContradiction(loE.r, upE.r)
+				continue
+			}
+		}
+
+		// redundancy -- from the last rule, so the first one of equal rules is kept
+		for i := len(entries) - 1; i >= 0; i-- {
+			e := entries[i]
+			if l.redundant[e.r.pos] {
+				continue
+			}
+			var by []lintRule
+			for _, b := range e.bounds {
+				var implying *lintRule
+				for j, o := range entries {
+					if j == i || l.redundant[o.r.pos] {
+						continue
+					}
+					for _, ob := range o.bounds {
+						if ob.upper == b.upper && ob.tighter(b) >= 0 {
+							implying = &entries[j].r
+							break
+						}
+					}
+					if implying != nil {
+						break
+					}
+				}
+				if implying == nil {
+					by = nil
+					break
+				}
+				known := false
+				for _, r := range by {
+					known = known || r.pos == implying.pos
+				}
+				if !known {
+					by = append(by, *implying)
+				}
+			}
+			if len(by) > 0 {
+				l.reportRedundant(e.r, by...)
+			}
+		}
+	}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Values
+// ---------------------------------------------------------------------------------------------------------------------
+
+// lintOrdered
+//
+// Returns true, if values of bounds can be compared -- see lintCompare.
+func lintOrdered(bounds []lintBound) bool {
+	for _, b := range bounds {
+		if _, ok := lintCompare(b.v, b.v); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// lintCompare
+//
+// Compares values of the same type -- numbers, strings and time.Time.
+// Returns false, if values can't be compared.
+func lintCompare(a, b any) (int, bool) {
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !ra.IsValid() || !rb.IsValid() || ra.Type() != rb.Type() {
+		return 0, false
+	}
+
+	if ta, ok := a.(time.Time); ok {
+		tb := b.(time.Time)
+		return ternary(ta.Before(tb), -1, ternary(ta.After(tb), 1, 0)), true
+	}
+
+	switch ra.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, y := ra.Int(), rb.Int()
+		return ternary(x < y, -1, ternary(x > y, 1, 0)), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, y := ra.Uint(), rb.Uint()
+		return ternary(x < y, -1, ternary(x > y, 1, 0)), true
+	case reflect.Float32, reflect.Float64:
+		x, y := ra.Float(), rb.Float()
+		return ternary(x < y, -1, ternary(x > y, 1, 0)), true
+	case reflect.String:
+		x, y := ra.String(), rb.String()
+		return ternary(x < y, -1, ternary(x > y, 1, 0)), true
+	}
+	return 0, false
+}
+
+// lintEqual
+//
+// Returns true, if values are equal -- same as "==" for comparable values.
+func lintEqual(a, b any) bool {
+	if a != nil && b != nil && reflect.TypeOf(a) == reflect.TypeOf(b) && reflect.TypeOf(a).Comparable() {
+		return a == b
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// lintSameArgs
+//
+// Returns true, if arguments of rules are equal -- elements of slices of the rule "r" have to be in slices
+// of the rule "o" regardless of the order (see lintContains), other arguments have to be equal (see lintEqual).
+func lintSameArgs(r, o lintRule) bool {
+	ra, oa := r.args(), o.args()
+	if len(ra) != len(oa) {
+		return false
+	}
+	for i := range ra {
+		rs := reflect.ValueOf(ra[i])
+		if rs.Kind() != reflect.Slice || reflect.TypeOf(ra[i]) != reflect.TypeOf(oa[i]) {
+			if !lintEqual(ra[i], oa[i]) {
+				return false
+			}
+			continue
+		}
+		for j := 0; j < rs.Len(); j++ {
+			if !lintContains(oa[i], rs.Index(j).Interface()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// lintLen
+//
+// Returns the number of elements of the slice.
+func lintLen(s any) int {
+	rs := reflect.ValueOf(s)
+	if rs.Kind() != reflect.Slice && rs.Kind() != reflect.Array {
+		return 0
+	}
+	return rs.Len()
+}
+
+// lintContains
+//
+// Returns true, if the slice contains the value.
+func lintContains(s any, v any) bool {
+	rs := reflect.ValueOf(s)
+	if rs.Kind() != reflect.Slice && rs.Kind() != reflect.Array {
+		return false
+	}
+	for i := 0; i < rs.Len(); i++ {
+		if lintEqual(rs.Index(i).Interface(), v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package assert
+
+import (
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_Lint(t *testing.T) {
+	fnStrings := func(issues []LintIssue) []string {
+		ss := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			ss = append(ss, issue.String())
+		}
+		return ss
+	}
+
+	t.Run("clean", func(t *testing.T) {
+		tAssert.Nil(t, Str().Word().LenInRange(3, 10).NotIn([]string{"admin"}).Lint())
+		tAssert.Nil(t, Num[int]().Positive().Less(100).NotEq(50).Lint())
+		tAssert.Nil(t, Time().NotZero().LessEqFn(time.Now).Lint())
+		tAssert.Nil(t, SliceCmp[[]int]().NotEmpty().Uniques().UniquesLenMax(5).Lint())
+		tAssert.Nil(t, Str().Lint())
+	})
+
+	t.Run("contradiction", func(t *testing.T) {
+		tAssert.Equal(
+			t,
+			[]LintIssue{{Kind: LintContradiction, Rules: []int{1, 2}, Msg: "#1 LenMin(10) contradicts #2 LenMax(5)"}},
+			Str().LenMin(10).LenMax(5).Lint(),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`contradiction: #1 Eq("a") contradicts #2 NotEq("a")`},
+			fnStrings(Str().Eq("a").NotEq("a").Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`contradiction: #1 Positive() contradicts #2 Negative()`},
+			fnStrings(Num[int]().Positive().Negative().Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`contradiction: #1 Eq("a") contradicts #2 In([]string{"b", "c"})`},
+			fnStrings(Str().Eq("a").In([]string{"b", "c"}).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`contradiction: #1 Eq(1) contradicts #2 Eq(2)`},
+			fnStrings(Num[int]().Eq(1).Eq(2).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`contradiction: #1 Empty() contradicts #2 NotEmpty()`},
+			fnStrings(SliceAny[[]int]().Empty().NotEmpty().Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`contradiction: #1 In([]string{"a", "b"}) contradicts #2 NotIn([]string{"b", "a"})`},
+			fnStrings(Str().In([]string{"a", "b"}).NotIn([]string{"b", "a"}).Lint()),
+		)
+		tAssert.Len(t, Str().In([]string{"a"}).NotIn([]string{"b", "a"}).Lint(), 1)
+		tAssert.Nil(t, Str().In([]string{"a", "c"}).NotIn([]string{"b", "a"}).Lint())
+		tAssert.Len(t, Num[int]().InRange(1, 5).GreaterEq(6).Lint(), 1)
+		tAssert.Len(t, Str().RunesEq(3).RunesMax(2).Lint(), 1)
+		tAssert.Len(t, Time().Less(time.Unix(0, 0)).Greater(time.Unix(10, 0)).Lint(), 1)
+	})
+
+	t.Run("emptySet", func(t *testing.T) {
+		tAssert.Equal(
+			t,
+			[]string{
+				`emptySet: #1 In([]int{}) never passes -- no elements provided`,
+				`emptySet: #2 InRange(10, 1) never passes -- min is greater than max`,
+			},
+			fnStrings(Num[int]().In([]int{}).InRange(10, 1).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{
+				`emptySet: #1 PrefixIn([]string(nil)) never passes -- no elements provided`,
+				`emptySet: #2 NotContainsStr("") never passes -- empty string provided`,
+			},
+			fnStrings(Str().PrefixIn(nil).NotContainsStr("").Lint()),
+		)
+	})
+
+	t.Run("negativeLen", func(t *testing.T) {
+		tAssert.Equal(
+			t,
+			[]string{
+				`negativeLen: #1 LenMin(-1) has negative length`,
+				`negativeLen: #2 RunesInRange(-2, 3) has negative length`,
+			},
+			fnStrings(Str().LenMin(-1).RunesInRange(-2, 3).Lint()),
+		)
+	})
+
+	t.Run("redundant", func(t *testing.T) {
+		tAssert.Equal(
+			t,
+			[]LintIssue{{
+				Kind:  LintRedundant,
+				Rules: []int{1, 2},
+				Msg:   "#1 LenMin(3) is redundant -- implied by #2 LenMin(5)",
+			}},
+			Str().LenMin(3).LenMin(5).Lint(),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #2 Word() is redundant -- duplicates #1 Word()`},
+			fnStrings(Str().Word().Word().Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #2 LenMax(5) is redundant -- duplicates #1 LenMax(5)`},
+			fnStrings(Str().LenMax(5).LenMax(5).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #2 In([]string{"b", "a"}) is redundant -- duplicates #1 In([]string{"a", "b"})`},
+			fnStrings(Str().In([]string{"a", "b"}).In([]string{"b", "a"}).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #1 NotIn([]string(nil)) always passes -- no elements provided`},
+			fnStrings(Str().NotIn(nil).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #2 InRange(0, 10) is redundant -- implied by #1 Eq(5)`},
+			fnStrings(Num[int]().Eq(5).InRange(0, 10).Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #2 NotEq("b") is redundant -- implied by #1 Eq("a")`},
+			fnStrings(Str().Eq("a").NotEq("b").Lint()),
+		)
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #1 GreaterEq(0) is redundant -- implied by #2 Positive()`},
+			fnStrings(Num[int]().GreaterEq(0).Positive().Lint()),
+		)
+
+		// partially implied rules are not redundant
+		tAssert.Nil(t, Str().LenMin(5).LenInRange(3, 10).Lint())
+		tAssert.Equal(
+			t,
+			[]string{`redundant: #1 LenMin(3) is redundant -- implied by #2 LenInRange(5, 10)`},
+			fnStrings(Str().LenMin(3).LenInRange(5, 10).Lint()),
+		)
+	})
+
+	t.Run("ignored", func(t *testing.T) {
+		tAssert.Nil(t, Str().LenMin(10).LenMax(5).Warn().Lint())
+		tAssert.Nil(t, Str().LenMin(10).Not().LenMax(5).Lint())
+		tAssert.Nil(t, Num[int]().Greater(10).LessFn(func() int { return 5 }).Lint())
+		tAssert.Nil(t, Str().Custom(func(v string) error { return nil }).Custom(func(v string) error { return nil }).Lint())
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		a := Str().
+			LenMax(5).
+			LenMin(10).Groups("create").
+			LenMin(1).Groups("update").
+			LenMin(1).Groups("create", "update")
+
+		tAssert.Equal(
+			t,
+			[]string{
+				`contradiction: #1 LenMax(5) contradicts #2 LenMin(10) (groups: create)`,
+				`redundant: #4 LenMin(1) is redundant -- duplicates #3 LenMin(1) (groups: update)`,
+			},
+			fnStrings(a.Lint()),
+		)
+
+		// ungrouped rules
+		tAssert.Equal(
+			t,
+			[]LintIssue{{Kind: LintEmptySet, Rules: []int{1}, Msg: "#1 In([]string(nil)) never passes -- no elements provided"}},
+			Str().In(nil).LenMax(1).Groups("a").LenMax(2).Groups("b").Lint(),
+		)
+	})
+
+	t.Run("Validator", func(t *testing.T) {
+		tAssert.Len(t, Str().LenMin(10).LenMax(5).Freeze().Lint(), 1)
+	})
+}
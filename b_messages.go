@@ -0,0 +1,454 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// #####################################################################################################################
+// INTERFACES
+// #####################################################################################################################
+
+// MessageArgs
+//
+// Arguments to build a message of a failed rule.
+type MessageArgs struct {
+	// Path -- name or path of the checked value, if provided -- see Named.
+	Path string
+	// Value -- the checked value.
+	Value any
+	// Params -- parameters of the rule by their names.
+	Params map[string]any
+	// Facts -- computed facts about the value by their names, e.g. "len".
+	Facts map[string]any
+
+	// formatter -- formatter of the chain, if set -- see Formatter.
+	formatter ValueFormatter
+}
+
+// FormatVal
+//
+// Formats the value for the message -- with the formatter of the chain, if set, or with the global one otherwise.
+//
+// See ValueFormatter.
+func (args MessageArgs) FormatVal(v any) string {
+	if args.formatter != nil {
+		return args.formatter.FormatValue(v)
+	}
+	return fmtVal(v)
+}
+
+// MessageCatalog
+//
+// Messages of the rules for a single locale.
+type MessageCatalog interface {
+	// Message
+	//
+	// Returns the message of the rule with the given code.
+	// Returns false, if the catalog has no message for the code.
+	Message(code string, args MessageArgs) (string, bool)
+}
+
+// Translator
+//
+// Messages of the rules for any locale.
+//
+// By default, the package uses a translator based on catalogs registered via RegisterCatalog.
+// Use SetTranslator to plug in any other translation system.
+type Translator interface {
+	// Translate
+	//
+	// Returns the message of the rule with the given code in the given locale.
+	// Returns false, if there is no message for the code in the locale.
+	Translate(locale string, code string, args MessageArgs) (string, bool)
+}
+
+// #####################################################################################################################
+// TEMPLATES
+// #####################################################################################################################
+
+// PluralRule
+//
+// Returns index of the plural form for the number.
+type PluralRule func(n int64) int
+
+// PluralEn
+//
+// Plural rule for English and similar languages -- forms: one, other.
+func PluralEn(n int64) int {
+	return ternary[int](n == 1 || n == -1, 0, 1)
+}
+
+// PluralRu
+//
+// Plural rule for Russian and similar languages -- forms: one, few, many.
+func PluralRu(n int64) int {
+	if n < 0 {
+		n = -n
+	}
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// MessageTemplates
+//
+// Message catalog based on text templates by rule codes.
+//
+// Templates support placeholders:
+//   - `{value}` -- the checked value;
+//   - `{path}` -- name or path of the checked value (see Named);
+//   - `{name}` -- parameter or computed fact with the name, e.g. `{max}` or `{len}`;
+//     computed facts are "len" (length), "runes" (runes count), "uniquesLen" (count of unique elements),
+//     "elem" (mismatched element), "errors" (list of failures of alternatives of logical combinators)
+//     and "matched" (numbers of passed alternatives);
+//   - `{name|form1|form2|...}` -- plural form for the numeric parameter or fact with the name,
+//     selected by the Plural rule, e.g. `{max} {max|character|characters}`.
+//
+// Unknown placeholders are left as is.
+//
+// Custom messages of rules and results are also processed as such templates.
+type MessageTemplates struct {
+	// Templates -- templates by rule codes.
+	Templates map[string]string
+	// Plural -- plural rule of the locale. PluralEn is used, if nil.
+	Plural PluralRule
+}
+
+// Message
+//
+// See MessageCatalog.Message.
+func (c *MessageTemplates) Message(code string, args MessageArgs) (string, bool) {
+	tpl, ok := c.Templates[code]
+	if !ok {
+		return "", false
+	}
+	return renderMsgTemplate(tpl, args, c.Plural), true
+}
+
+func msgArg(name string, args MessageArgs) (any, bool) {
+	if name == "value" {
+		return args.Value, true
+	}
+	if p, ok := args.Params[name]; ok {
+		return p, true
+	}
+	f, ok := args.Facts[name]
+	return f, ok
+}
+
+func msgArgInt(v any) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), true
+	default:
+		return 0, false
+	}
+}
+
+func renderMsgTemplate(tpl string, args MessageArgs, plural PluralRule) string {
+	if plural == nil {
+		plural = PluralEn
+	}
+
+	b := strings.Builder{}
+	for {
+		start := strings.IndexByte(tpl, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(tpl[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		b.WriteString(tpl[:start])
+		b.WriteString(renderMsgPlaceholder(tpl[start:end+1], args, plural))
+		tpl = tpl[end+1:]
+	}
+	b.WriteString(tpl)
+
+	return b.String()
+}
+
+func renderMsgPlaceholder(placeholder string, args MessageArgs, plural PluralRule) string {
+	parts := strings.Split(placeholder[1:len(placeholder)-1], "|")
+
+	if len(parts) == 1 && parts[0] == "path" {
+		return args.Path
+	}
+
+	v, ok := msgArg(parts[0], args)
+	if !ok {
+		return placeholder
+	}
+
+	if len(parts) == 1 {
+		switch arg := v.(type) {
+		case altErrs:
+			return arg.String()
+		case ruleExpectation:
+			return arg.render(args)
+		}
+		return args.FormatVal(v)
+	}
+
+	n, ok := msgArgInt(v)
+	if !ok {
+		return placeholder
+	}
+	forms := parts[1:]
+	i := plural(n)
+	if i < 0 || i >= len(forms) {
+		i = len(forms) - 1
+	}
+	return forms[i]
+}
+
+// #####################################################################################################################
+// ENGLISH
+// #####################################################################################################################
+
+// LocaleEn
+//
+// Locale of the built-in English catalog. It is the default locale.
+const LocaleEn = "en"
+
+// EnglishCatalog
+//
+// Returns a copy of the built-in English catalog.
+//
+// Can be used as a base for other catalogs, e.g. to replace only some messages.
+func EnglishCatalog() *MessageTemplates {
+	c := &MessageTemplates{
+		Templates: make(map[string]string, len(catalogEn.Templates)),
+		Plural:    catalogEn.Plural,
+	}
+	for code, tpl := range catalogEn.Templates {
+		c.Templates[code] = tpl
+	}
+	return c
+}
+
+var catalogEn = &MessageTemplates{
+	Plural: PluralEn,
+	Templates: map[string]string{
+		// comparable
+		CodeEq:    "value expects to be equal to {eq}, got {value}",
+		CodeNotEq: "value expects to be not equal to {notEq}, got {value}",
+		CodeIn:    "value expects to be in {in}, got {value}",
+		CodeNotIn: "value expects to be not in {notIn}, got {value}",
+		// ordered
+		CodeLess:          "value expects to be less than {than}, got {value}",
+		CodeLessEq:        "value expects to be less or equal to {than}, got {value}",
+		CodeLessAny:       "value expects to be less than any of {elems}, got {value}",
+		CodeLessEqAny:     "value expects to be less or equal to any of {elems}, got {value}",
+		CodeLessEach:      "value expects to be less than each of {elems}, got {value}",
+		CodeLessEqEach:    "value expects to be less or equal to each of {elems}, got {value}",
+		CodeGreater:       "value expects to be greater than {than}, got {value}",
+		CodeGreaterEq:     "value expects to be greater or equal to {than}, got {value}",
+		CodeGreaterAny:    "value expects to be greater than any of {elems}, got {value}",
+		CodeGreaterEqAny:  "value expects to be greater or equal to any of {elems}, got {value}",
+		CodeGreaterEach:   "value expects to be greater than each of {elems}, got {value}",
+		CodeGreaterEqEach: "value expects to be greater or equal to each of {elems}, got {value}",
+		CodeInRange:       "value expects to be in range [{min}, {max}], got {value}",
+		CodeNotInRange:    "value expects to be not in range [{min}, {max}], got {value}",
+		// len
+		CodeLenEq:         "length of {value} expects to be equal to {eq}, got {len}",
+		CodeLenNotEq:      "length of {value} expects to be not equal to {notEq}, got {len}",
+		CodeLenMin:        "length of {value} expects to be greater or equal to {min}, got {len}",
+		CodeLenMax:        "length of {value} expects to be less or equal to {max}, got {len}",
+		CodeLenInRange:    "length of {value} expects to be in range [{min}, {max}], got {len}",
+		CodeLenNotInRange: "length of {value} expects to be not in range [{min}, {max}], got {len}",
+		// slice
+		CodeEmpty:            "value expects to be empty, got {value}",
+		CodeNotEmpty:         "value expects to be not empty, got {value}",
+		CodeCustomElementAny: "value expects any element to match {condition} condition, got none matched in {value}",
+		CodeCustomElementEach: "value expects each element to match {condition} condition, " +
+			"got at least {elem} at index {index} not matched in {value}",
+		CodeCustomElementNone: "value expects none element to match {condition} condition, " +
+			"got at least {elem} at index {index} matched in {value}",
+		CodeContains:     "value expects to contain {elem}, got {value}",
+		CodeNotContains:  "value expects to not contain {elem}, got {value}",
+		CodeContainsAny:  "value expects to contain any of {elems}, got {value}",
+		CodeContainsEach: "value expects to contain each of {elems}, got {value}",
+		CodeContainsNone: "value expects to contain none of {elems}, got {value}",
+		CodeUniques:      "value expects all elements to be unique, got {value}",
+		CodeUniquesLenEq: "length of unique elements sub-slice of {value} expects to be equal to {eq}, " +
+			"got {uniquesLen}",
+		CodeUniquesLenNotEq: "length of unique elements sub-slice of {value} expects to be not equal to {notEq}, " +
+			"got {uniquesLen}",
+		CodeUniquesLenMin: "length of unique elements sub-slice of {value} expects to be greater or equal to {min}, " +
+			"got {uniquesLen}",
+		CodeUniquesLenMax: "length of unique elements sub-slice of {value} expects to be less or equal to {max}, " +
+			"got {uniquesLen}",
+		CodeUniquesLenInRange: "length of unique elements sub-slice of {value} expects to be in range [{min}, {max}], " +
+			"got {uniquesLen}",
+		CodeUniquesLenNotInRange: "length of unique elements sub-slice of {value} expects to be not in range " +
+			"[{min}, {max}], got {uniquesLen}",
+		// string
+		CodePrefixEq:        "value expects to have prefix equal to {eq}, got {value}",
+		CodePrefixNotEq:     "value expects to have prefix not equal to {notEq}, got {value}",
+		CodePrefixIn:        "value expects to have any of {in} prefixes, got {value}",
+		CodePrefixNotIn:     "value expects to have none of {notIn} prefixes, got {value}",
+		CodeSuffixEq:        "value expects to have suffix equal to {eq}, got {value}",
+		CodeSuffixNotEq:     "value expects to have suffix not equal to {notEq}, got {value}",
+		CodeSuffixIn:        "value expects to have any of {in} suffixes, got {value}",
+		CodeSuffixNotIn:     "value expects to have none of {notIn} suffixes, got {value}",
+		CodeContainsStr:     "value expects to contain {substr} substring, got {value}",
+		CodeNotContainsStr:  "value expects to not contain {substr} substring, got {value}",
+		CodeContainsStrAny:  "value expects to contain any of {substrs} substrings, got {value}",
+		CodeContainsStrEach: "value expects to contain each of {substrs} substrings, got {value}",
+		CodeContainsStrNone: "value expects to contain none of {substrs} substrings, got {value}",
+		CodeRunesEq:         "runes count of {value} expects to be equal to {eq}, got {runes}",
+		CodeRunesNotEq:      "runes count of {value} expects to be not equal to {notEq}, got {runes}",
+		CodeRunesMin:        "runes count of {value} expects to be greater or equal to {min}, got {runes}",
+		CodeRunesMax:        "runes count of {value} expects to be less or equal to {max}, got {runes}",
+		CodeRunesInRange:    "runes count of {value} expects to be in range [{min}, {max}], got {runes}",
+		CodeRunesNotInRange: "runes count of {value} expects to be not in range [{min}, {max}], got {runes}",
+		CodeRegexp:          "value expects to be matched to regexp {pattern}, got {value}",
+		// any
+		CodeNotZero:    "value expects to be non-zero, got {value}",
+		CodeNotNilDeep: "value expects to be non-nil in depth, got {value}",
+		// common
+		CodeNot: "value expects not to match {expected}, got {value}",
+		// logic
+		CodeAnyOf:     "value expects to pass any of {count} alternatives, got {value}: {errors}",
+		CodeOneOf:     "value expects to pass exactly one of {count} alternatives, got {value} passing none: {errors}",
+		CodeOneOfMany: "value expects to pass exactly one of {count} alternatives, got {value} passing {matched}",
+		CodeAllOf:     "value expects to pass all of {count} alternatives, got {value}: {errors}",
+		CodeNoneOf:    "value expects to pass none of {count} alternatives, got {value} passing {matched}",
+
+		// Context
+		CodeTimeout: "check of {value} expects to complete within {timeout}",
+	},
+}
+
+// #####################################################################################################################
+// REGISTRY
+// #####################################################################################################################
+
+var messages = struct {
+	mu         sync.RWMutex
+	locale     string
+	translator Translator
+	catalogs   map[string]MessageCatalog
+}{
+	locale:     LocaleEn,
+	translator: catalogsTranslator{},
+	catalogs:   map[string]MessageCatalog{LocaleEn: catalogEn},
+}
+
+// catalogsTranslator
+//
+// Default translator -- uses catalogs registered via RegisterCatalog.
+type catalogsTranslator struct{}
+
+func (catalogsTranslator) Translate(locale string, code string, args MessageArgs) (string, bool) {
+	messages.mu.RLock()
+	c, ok := messages.catalogs[locale]
+	messages.mu.RUnlock()
+
+	if !ok {
+		return "", false
+	}
+	return c.Message(code, args)
+}
+
+// RegisterCatalog
+//
+// Registers the catalog for the locale. Replaces previously registered catalog of the locale.
+//
+// Panics, if catalog is nil.
+func RegisterCatalog(locale string, catalog MessageCatalog) {
+	if catalog == nil {
+		panic(fmt.Errorf("RegisterCatalog expects not nil catalog"))
+	}
+
+	messages.mu.Lock()
+	defer messages.mu.Unlock()
+	messages.catalogs[locale] = catalog
+}
+
+// SetTranslator
+//
+// Replaces the translator used to build default messages.
+// Nil restores the default translator based on catalogs registered via RegisterCatalog.
+func SetTranslator(translator Translator) {
+	messages.mu.Lock()
+	defer messages.mu.Unlock()
+	messages.translator = ternary[Translator](translator != nil, translator, catalogsTranslator{})
+}
+
+// SetLocale
+//
+// Sets the locale of default messages. LocaleEn by default.
+func SetLocale(locale string) {
+	messages.mu.Lock()
+	defer messages.mu.Unlock()
+	messages.locale = locale
+}
+
+// Locale
+//
+// Returns the locale of default messages.
+func Locale() string {
+	messages.mu.RLock()
+	defer messages.mu.RUnlock()
+	return messages.locale
+}
+
+// localePlural
+//
+// Returns the plural rule of the catalog registered for the locale, if it is MessageTemplates, or PluralEn otherwise.
+func localePlural(locale string) PluralRule {
+	messages.mu.RLock()
+	c := messages.catalogs[locale]
+	messages.mu.RUnlock()
+
+	if tc, ok := c.(*MessageTemplates); ok && tc.Plural != nil {
+		return tc.Plural
+	}
+	return PluralEn
+}
+
+// translateMsg
+//
+// Returns the message of the rule in the locale.
+//
+// Falls back to the default locale (see SetLocale), if there is no message in the locale,
+// then to the built-in English catalog and to the code itself finally.
+func translateMsg(locale string, code string, args MessageArgs) string {
+	messages.mu.RLock()
+	translator := messages.translator
+	defaultLocale := messages.locale
+	messages.mu.RUnlock()
+
+	if msg, ok := translator.Translate(locale, code, args); ok {
+		return msg
+	}
+	if locale != defaultLocale {
+		if msg, ok := translator.Translate(defaultLocale, code, args); ok {
+			return msg
+		}
+	}
+	if msg, ok := catalogEn.Message(code, args); ok {
+		return msg
+	}
+	return code
+}
+
+// #####################################################################################################################
@@ -0,0 +1,130 @@
+package assert
+
+import (
+	"errors"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Messages_Templates(t *testing.T) {
+	args := MessageArgs{
+		Value:  "hello",
+		Params: map[string]any{"max": 2, "name": "x"},
+		Facts:  map[string]any{"len": 5},
+	}
+
+	t.Run("placeholders", func(t *testing.T) {
+		tAssert.Equal(t, `"hello": 5 > 2`, renderMsgTemplate("{value}: {len} > {max}", args, nil))
+		tAssert.Equal(t, `no placeholders`, renderMsgTemplate("no placeholders", args, nil))
+		tAssert.Equal(t, `{unknown} "x"`, renderMsgTemplate("{unknown} {name}", args, nil))
+		tAssert.Equal(t, `"x" {name`, renderMsgTemplate("{name} {name", args, nil))
+	})
+
+	t.Run("plural", func(t *testing.T) {
+		tAssert.Equal(t, "2 chars", renderMsgTemplate("{max} {max|char|chars}", args, nil))
+		tAssert.Equal(t, "5 символов", renderMsgTemplate("{len} {len|символ|символа|символов}", args, PluralRu))
+		tAssert.Equal(t, "{name|a|b}", renderMsgTemplate("{name|a|b}", args, nil))
+		tAssert.Equal(t, "b", renderMsgTemplate("{len|a|b}", args, func(n int64) int { return 100 }))
+	})
+
+	t.Run("PluralEn", func(t *testing.T) {
+		for n, form := range map[int64]int{0: 1, 1: 0, -1: 0, 2: 1, 11: 1, 21: 1} {
+			tAssert.Equal(t, form, PluralEn(n), n)
+		}
+	})
+
+	t.Run("PluralRu", func(t *testing.T) {
+		for n, form := range map[int64]int{0: 2, 1: 0, 2: 1, 4: 1, 5: 2, 11: 2, 12: 2, 21: 0, 22: 1, 111: 2, -3: 1} {
+			tAssert.Equal(t, form, PluralRu(n), n)
+		}
+	})
+
+	t.Run("MessageTemplates", func(t *testing.T) {
+		c := &MessageTemplates{Templates: map[string]string{CodeLenMax: "max {max}"}}
+
+		msg, ok := c.Message(CodeLenMax, args)
+		tAssert.True(t, ok)
+		tAssert.Equal(t, "max 2", msg)
+
+		_, ok = c.Message(CodeLenMin, args)
+		tAssert.False(t, ok)
+	})
+}
+
+func Test_Messages_Catalogs(t *testing.T) {
+	t.Cleanup(func() {
+		SetLocale(LocaleEn)
+		SetTranslator(nil)
+	})
+
+	t.Run("english", func(t *testing.T) {
+		tAssert.Equal(t, LocaleEn, Locale())
+		tAssert.Equal(
+			t,
+			`length of "hello" expects to be less or equal to 2, got 5`,
+			Str().LenMax(2).Check("hello").Error(),
+		)
+		tAssert.Equal(t, "value expects to be in range [1, 5], got 7", Num[int]().InRange(1, 5).Check(7).Error())
+		for code, tpl := range catalogEn.Templates {
+			tAssert.NotEmpty(t, code)
+			tAssert.NotEmpty(t, tpl)
+		}
+	})
+
+	t.Run("EnglishCatalog", func(t *testing.T) {
+		c := EnglishCatalog()
+		c.Templates[CodeLenMax] = "changed"
+		tAssert.NotEqual(t, "changed", catalogEn.Templates[CodeLenMax])
+	})
+
+	t.Run("registered catalog", func(t *testing.T) {
+		RegisterCatalog("ru", &MessageTemplates{
+			Templates: map[string]string{
+				CodeLenMax: "длина {value} должна быть не более {max} {max|символа|символов|символов}",
+			},
+			Plural: PluralRu,
+		})
+		SetLocale("ru")
+
+		err := Str().LenMax(5).Check("hello, world")
+		tAssert.Equal(t, `длина "hello, world" должна быть не более 5 символов`, err.Error())
+
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, `length of "hello, world" expects to be less or equal to 5, got 12`, ae.LocalizedMsg(LocaleEn))
+		tAssert.Equal(t, ae.Error(), ae.LocalizedMsg("ru"))
+		tAssert.Equal(t, ae.Error(), ae.LocalizedMsg("unknown-locale"))
+
+		// fallback to english
+		tAssert.Equal(t, "value expects to be not empty, got []int(nil)", SliceAny[[]int]().NotEmpty().Check(nil).Error())
+
+		// custom message is not translated
+		err = Str().LenMax(5, "custom").Check("hello, world")
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "custom", ae.LocalizedMsg(LocaleEn))
+
+		SetLocale(LocaleEn)
+		tAssert.Equal(t, `length of "hello, world" expects to be less or equal to 5, got 12`, Str().LenMax(5).Check("hello, world").Error())
+	})
+
+	t.Run("translator", func(t *testing.T) {
+		SetTranslator(testTranslator{})
+		tAssert.Equal(t, "en:LenMax:5", Str().LenMax(5).Check("hello, world").Error())
+		tAssert.Equal(t, "value expects to be non-zero, got 0", NotZeroCheck(0).Error())
+		SetTranslator(nil)
+		tAssert.Equal(t, `length of "hello, world" expects to be less or equal to 5, got 12`, Str().LenMax(5).Check("hello, world").Error())
+	})
+
+	t.Run("RegisterCatalog nil", func(t *testing.T) {
+		tAssert.Panics(t, func() { RegisterCatalog("xx", nil) })
+	})
+}
+
+type testTranslator struct{}
+
+func (testTranslator) Translate(locale string, code string, args MessageArgs) (string, bool) {
+	if code == CodeNotZero {
+		return "", false
+	}
+	return locale + ":" + code + ":" + fmtVal(args.Params["max"]), true
+}
@@ -0,0 +1,411 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// mixinChain
+//
+// Methods to adjust the chain itself or its preceding rule.
+type mixinChain[A assertInterface[T], T any] struct {
+	assert A
+}
+
+func newMixinChain[A assertInterface[T], T any](assert A) *mixinChain[A, T] {
+	return &mixinChain[A, T]{assert: assert}
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Message
+// ---------------------------------------------------------------------------------------------------------------------
+
+// MsgFn
+//
+// Replaces the message of the preceding rule with the message returned by "fn" when the rule fails --
+// for fully dynamic messages, when templates of custom messages are not enough (see MessageTemplates).
+//
+// Empty message keeps the original one.
+//
+// Panics, if there is no preceding rule or "fn" is nil.
+func (m *mixinChain[A, T]) MsgFn(fn func(v T) string) A {
+	if fn == nil {
+		panic(fmt.Errorf("%T.MsgFn expects not nil fn", m.assert))
+	}
+
+	m.assert.wrapLastCheck(func(check func(ctx context.Context, v T) error) func(ctx context.Context, v T) error {
+		return func(ctx context.Context, v T) error {
+			err := check(ctx, v)
+			if err == nil {
+				return nil
+			}
+			msg := fn(v)
+			if msg == "" {
+				return err
+			}
+			if ae, ok := err.(*AssertionError); ok {
+				return ae.withCustomMsg(msg)
+			}
+			return errors.New(msg)
+		}
+	})
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Negation
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Not
+//
+// Negates the following rule -- it fails, if the original rule passes, and passes otherwise,
+// e.g. `Not().Regexp(re)` or `Not().Word()`.
+//
+// Fails with CodeNot and the parameters of the original rule with its code in the "rule" parameter --
+// the message shows the expectation of the original rule, e.g. `value expects not to match Eq rule (equal to 7), got 7`.
+// Custom message of the original rule is used for the negated one.
+// Errors of the cancellation of the context are not negated.
+//
+// Repeated calls cancel each other. Has no effect, if there is no following rule.
+func (m *mixinChain[A, T]) Not() A {
+	m.assert.negateNext()
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Timeout
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Timeout
+//
+// Limits the time of the preceding rule -- e.g. of CustomCtx with a database call.
+//
+// The context of the rule is canceled after the timeout. If the rule does not complete within it,
+// the rule fails with AssertionError of CodeTimeout, that satisfies `errors.Is(err, ErrTimeout)`.
+// The rule with a timeout runs in a separate goroutine, so the timeout is respected even if the rule ignores the context.
+//
+// Zero timeout disables the limit.
+//
+// Panics, if there is no preceding rule or timeout is negative.
+func (m *mixinChain[A, T]) Timeout(timeout time.Duration) A {
+	if timeout < 0 {
+		panic(fmt.Errorf("%T.Timeout expects not negative timeout", m.assert))
+	}
+
+	m.assert.setLastTimeout(timeout)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Severity
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Warn
+//
+// Makes the preceding rule advisory (SeverityWarning) --
+// e.g. recommended length of a password in addition to the mandatory one.
+//
+// Warnings are reported only by Validate -- separately from errors.
+// Other result methods (Check, CheckAll, Must, etc.) skip warning rules.
+//
+// Panics, if there is no preceding rule.
+func (m *mixinChain[A, T]) Warn() A {
+	m.assert.setLastSeverity(SeverityWarning)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Flow
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Bail
+//
+// Makes CheckAll-family methods stop, if the preceding rule fails -- e.g. not to report a pointless regexp failure
+// after NotEmpty failure.
+//
+// Panics, if there is no preceding rule.
+func (m *mixinChain[A, T]) Bail() A {
+	m.assert.setLastBail()
+	return m.assert
+}
+
+// DependsOn
+//
+// Makes CheckAll-family methods skip the preceding rule, if any of earlier rules with the given codes failed
+// (see b_codes.go), or if any of earlier rules failed, if no codes given.
+//
+// Aliases of built-in rules are matched by their own codes too, e.g. CodeNotEmpty for Str().NotEmpty().
+// Custom rules have no codes, so rules can depend on them only without codes.
+// Skipped rules are not considered as failed.
+//
+// Panics, if there is no preceding rule.
+func (m *mixinChain[A, T]) DependsOn(codes ...string) A {
+	m.assert.setLastDeps(codes)
+	return m.assert
+}
+
+// MaxErrors
+//
+// Limits the number of errors of CheckAll-family methods -- they stop after "n" failed rules.
+//
+// 0 means no limit.
+//
+// Panics, if "n" is negative.
+func (m *mixinChain[A, T]) MaxErrors(n int) A {
+	if n < 0 {
+		panic(fmt.Errorf("%T.MaxErrors expects not negative n", m.assert))
+	}
+
+	m.assert.setMaxErrors(n)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Groups
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Groups
+//
+// Adds the preceding rule to the given groups -- such rule is run only if any of its groups is selected
+// via WithGroups in the context of the result method, or if no groups selected at all.
+//
+// Useful for rules depending on the scenario -- e.g. NotEmpty on create, but not on update,
+// where the empty value means "unchanged".
+//
+// Panics, if there is no preceding rule, no groups given or any of them is empty.
+func (m *mixinChain[A, T]) Groups(groups ...string) A {
+	if len(groups) == 0 {
+		panic(fmt.Errorf("%T.Groups expects at least one group", m.assert))
+	}
+	for _, g := range groups {
+		if g == "" {
+			panic(fmt.Errorf("%T.Groups expects not empty groups", m.assert))
+		}
+	}
+
+	m.assert.setLastGroups(groups)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Parallel
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Parallel
+//
+// Runs rules of the chain in parallel with at most "workers" rules at once in CheckAll-family methods
+// (CheckAll, CheckAllErr, MustAll, etc. including context-aware ones) -- for chains with slow custom rules
+// (remote lookups, heavy regexps on large texts).
+//
+// Errors are returned in the order of rules -- same as in the sequential run.
+// Dependent rules (see DependsOn) and rules after bail-out points (see Bail) wait for preceding rules
+// and are not run, if their dependencies fail or the bail-out point is reached.
+// Check, Must and MustGet (including context-aware ones) stop on the first failed rule, so they are always sequential.
+//
+// Custom rules must be safe for concurrent use. 1 restores the sequential run.
+//
+// Panics, if workers is less than 1.
+func (m *mixinChain[A, T]) Parallel(workers int) A {
+	if workers < 1 {
+		panic(fmt.Errorf("%T.Parallel expects at least 1 worker", m.assert))
+	}
+
+	m.assert.setWorkers(workers)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Errors
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Err
+//
+// Marks errors of the preceding rule with the sentinel error --
+// they satisfy `errors.Is(err, sentinel)` keeping their messages and details (e.g. errors.As to AssertionError).
+//
+// Panics, if there is no preceding rule or sentinel is nil.
+func (m *mixinChain[A, T]) Err(sentinel error) A {
+	if sentinel == nil {
+		panic(fmt.Errorf("%T.Err expects not nil sentinel", m.assert))
+	}
+
+	m.assert.wrapLastErr(sentinelWrapper(sentinel))
+	return m.assert
+}
+
+// ErrFn
+//
+// Replaces errors of the preceding rule with errors returned by "fn" --
+// e.g. to wrap them into domain errors via `fmt.Errorf("invalid name: %w", err)` or own error types.
+//
+// "fn" gets the final error of the rule -- with the path, custom message, etc.
+// Nil result keeps the original error.
+//
+// Panics, if there is no preceding rule or "fn" is nil.
+func (m *mixinChain[A, T]) ErrFn(fn func(err error) error) A {
+	if fn == nil {
+		panic(fmt.Errorf("%T.ErrFn expects not nil fn", m.assert))
+	}
+
+	m.assert.wrapLastErr(errFnWrapper(fn))
+	return m.assert
+}
+
+// ChainErr
+//
+// Works same as Err, but for errors of all rules of the chain.
+//
+// Errors of rules with own sentinels satisfy `errors.Is` for both sentinels.
+//
+// Panics, if sentinel is nil.
+func (m *mixinChain[A, T]) ChainErr(sentinel error) A {
+	if sentinel == nil {
+		panic(fmt.Errorf("%T.ChainErr expects not nil sentinel", m.assert))
+	}
+
+	m.assert.wrapErr(sentinelWrapper(sentinel))
+	return m.assert
+}
+
+// ChainErrFn
+//
+// Works same as ErrFn, but for errors of all rules of the chain.
+// Applied after wrappers of rules.
+//
+// Panics, if "fn" is nil.
+func (m *mixinChain[A, T]) ChainErrFn(fn func(err error) error) A {
+	if fn == nil {
+		panic(fmt.Errorf("%T.ChainErrFn expects not nil fn", m.assert))
+	}
+
+	m.assert.wrapErr(errFnWrapper(fn))
+	return m.assert
+}
+
+func errFnWrapper(fn func(err error) error) func(err error) error {
+	return func(err error) error {
+		if wErr := fn(err); wErr != nil {
+			return wErr
+		}
+		return err
+	}
+}
+
+// OnFailure
+//
+// Sets the handler of failures of Must-family methods of the chain -- instead of the global one
+// (see SetFailureHandler). Nil restores the global handler.
+func (m *mixinChain[A, T]) OnFailure(h FailureHandler) A {
+	m.assert.setFailureHandler(h)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Name
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Named
+//
+// Sets the name of the checked value -- e.g. name of the argument or field.
+//
+// Default messages are prefixed with the name, errors carry it in AssertionError.Path,
+// custom messages can use it via the `{path}` placeholder.
+//
+// Errors of nested chains (e.g. checked in the Custom rule) get their names joined to the name as a path:
+// "user" + "email" -> "user.email", "emails" + "[2]" -> "emails[2]".
+func (m *mixinChain[A, T]) Named(name string) A {
+	m.assert.setName(name)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Sensitive
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Sensitive
+//
+// Marks the checked value as sensitive (e.g. password or token) --
+// the value is redacted in default messages, templates of custom messages and structured errors.
+// Rule parameters stay visible.
+//
+// Redaction policy is global -- RedactMask by default, see SetRedactor.
+//
+// Errors of the Custom rule and messages of MsgFn are not redacted -- they are fully controlled by the client code.
+func (m *mixinChain[A, T]) Sensitive() A {
+	m.assert.setSensitive()
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Format
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Formatter
+//
+// Sets the formatter of values in messages of the chain -- instead of the global one (see SetValueFormatter).
+// Nil restores the global formatter.
+//
+// Errors of nested chains with own formatters keep their messages.
+func (m *mixinChain[A, T]) Formatter(f ValueFormatter) A {
+	m.assert.setFormatter(f)
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Composition
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Use
+//
+// Appends rules of the given chain of the same type (or of its Validator, see Freeze) --
+// e.g. to build stricter variants of a base chain:
+//
+//	var username = assert.Str().Word().RunesInRange(3, 32).Freeze()
+//
+//	assert.Str().Use(username).NotIn(reserved)
+//
+// Rules are copied in their order with their codes, parameters, custom messages and settings
+// (Warn, Groups, Timeout, Err, etc.), so further changes of the given chain do not affect this one.
+// Errors of the copied rules are wrapped with ChainErr / ChainErrFn wrappers of the given chain, if any.
+// Other settings of the given chain (Named, Formatter, Parallel, MaxErrors, OnFailure) are not used,
+// except for Sensitive -- this chain becomes sensitive, if the given one is.
+//
+// Other Checker implementations are added as a single custom rule and used as is.
+//
+// Panics, if the chain is nil or preceded by Not.
+func (m *mixinChain[A, T]) Use(chain Checker[T]) A {
+	if chain == nil {
+		panic(fmt.Errorf("%T.Use expects not nil chain", m.assert))
+	}
+
+	if c, ok := chain.(interface{ clone() *assert[T] }); ok {
+		m.assert.addRules(c.clone())
+	} else {
+		m.assert.addRules(&assert[T]{rules: []*rule[T]{{
+			code: CodeCustom,
+			check: func(ctx context.Context, v T) error {
+				return chain.CheckCtx(ctx, v)
+			},
+		}}})
+	}
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Freeze
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Freeze
+//
+// Returns the read-only snapshot of the chain -- see Validator.
+//
+// Further changes of the chain do not affect the snapshot -- as well as changes of chains used by its rules
+// (AnyOf, When, Use, etc.), since they are copied on registration.
+// Checker implementations other than assertion chains are used by rules as is,
+// so the snapshot is affected by their changes.
+// Use Clone of the chain to extend it instead.
+func (m *mixinChain[A, T]) Freeze() *Validator[T] {
+	return &Validator[T]{assert: m.assert.clone()}
+}
@@ -0,0 +1,400 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_MixinChain(t *testing.T) {
+	type testAssert struct {
+		*assert[string]
+		*mixinChain[*testAssert, string]
+		*mixinCustom[*testAssert, string]
+		*mixinLen[*testAssert, string]
+	}
+	fnNewAssert := func() *testAssert {
+		a := new(testAssert)
+		*a = testAssert{
+			assert:      newAssert[string](),
+			mixinChain:  newMixinChain[*testAssert, string](a),
+			mixinCustom: newMixinCustom[*testAssert, string](a),
+			mixinLen:    newMixinLen[*testAssert, string](a),
+		}
+		return a
+	}
+
+	// Message
+	// --------------------------------
+
+	t.Run("MsgFn", func(t *testing.T) {
+		fnMsg := func(v string) string { return "bad " + v }
+
+		a := fnNewAssert().LenMin(2).LenMax(3).MsgFn(fnMsg)
+		tAssert.NoError(t, a.Check("abc"))
+		tAssert.NotEqual(t, "bad a", a.Check("a").Error())
+		tAssert.Equal(t, "bad abcd", a.Check("abcd").Error())
+		tAssert.Equal(t, "e", a.Check("abcd", "e").Error())
+
+		var ae *AssertionError
+		tAssert.True(t, errors.As(a.Check("abcd"), &ae))
+		tAssert.Equal(t, CodeLenMax, ae.Rule)
+		tAssert.True(t, ae.IsCustomMsg())
+
+		// custom rule
+		a = fnNewAssert().Custom(func(v string) error { return errors.New("custom") }).MsgFn(fnMsg)
+		tAssert.Equal(t, "bad x", a.Check("x").Error())
+
+		// empty message keeps the original one
+		a = fnNewAssert().LenMax(3, "e1").MsgFn(func(v string) string { return "" })
+		tAssert.Equal(t, "e1", a.Check("abcd").Error())
+
+		tAssert.Panics(t, func() { fnNewAssert().MsgFn(fnMsg) })
+		tAssert.Panics(t, func() { fnNewAssert().LenMax(3).MsgFn(nil) })
+	})
+
+	t.Run("message templates", func(t *testing.T) {
+		a := fnNewAssert().LenMax(3, "{value} is too long: {len} > {max}")
+		tAssert.Equal(t, `"abcd" is too long: 4 > 3`, a.Check("abcd").Error())
+		tAssert.Equal(
+			t,
+			"at most 3 characters, got 4",
+			a.Check("abcd", "at most {max} {max|character|characters}, got {len}").Error(),
+		)
+
+		a = fnNewAssert().Custom(func(v string) error { return errors.New("custom") })
+		tAssert.Equal(t, `"x" is incorrect, {max}`, a.Check("x", "{value} is incorrect, {max}").Error())
+	})
+	// Flow
+	// --------------------------------
+
+	// Negation
+	// --------------------------------
+
+	t.Run("Not", func(t *testing.T) {
+		a := fnNewAssert().Not().LenMin(3).LenMax(5)
+		tAssert.NoError(t, a.Check("ab"))
+		tAssert.Error(t, a.Check("abc"))
+		tAssert.NoError(t, a.Check(""))
+
+		err := a.Check("abcd")
+		tAssert.Equal(t, `value expects not to match LenMin rule (length >= 3), got "abcd"`, err.Error())
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeNot, ae.Rule)
+		tAssert.Equal(t, map[string]any{"min": 3, "rule": CodeLenMin}, ae.Params)
+
+		// only the following rule is negated
+		tAssert.Len(t, a.CheckAll("abcdef"), 2)
+
+		// custom messages
+		tAssert.Equal(t, "shorter than 3 expected", fnNewAssert().Not().LenMin(3, "shorter than {min} expected").Check("abc").Error())
+		tAssert.Equal(t, "e", a.Check("abcd", "e").Error())
+
+		// custom rules
+		errCustom := errors.New("custom")
+		b := fnNewAssert().Not().Custom(func(v string) error { return ternary[error](v == "x", errCustom, nil) })
+		tAssert.NoError(t, b.Check("x"))
+		tAssert.Equal(t, `value expects not to match Custom rule (custom check), got "y"`, b.Check("y").Error())
+
+		// built-in rules of specific assertions
+		tAssert.NoError(t, Str().Not().Word().Check("Hello!"))
+		tAssert.Equal(t, `value expects not to match Word rule (matches Word), got "Hello"`, Str().Not().Word().Check("Hello").Error())
+		tAssert.Equal(
+			t,
+			"value expects not to match InRange rule (in range [<1>, <5>]), got <3>",
+			Num[int]().Formatter(ValueFormatterFunc(func(v any) string { return fmt.Sprintf("<%v>", v) })).
+				Not().InRange(1, 5).
+				Check(3).Error(),
+		)
+		tAssert.Equal(t, "LenMin rule (length >= 3)", fmt.Sprint(ae.Facts["expected"]))
+		tAssert.NoError(t, Num[int]().Not().InRange(1, 5).Check(7))
+		tAssert.NoError(t, Str().Not().AnyOf(Str().Empty(), Str().Numeric()).Check("abc"))
+		tAssert.Error(t, Str().Not().AnyOf(Str().Empty(), Str().Numeric()).Check("12"))
+
+		// repeated calls cancel each other, no following rule
+		tAssert.Error(t, fnNewAssert().Not().Not().LenMin(3).Check("ab"))
+		tAssert.NoError(t, fnNewAssert().Not().Check("ab"))
+
+		// cancellation of the context is not negated
+		ctx, cancel := context.WithCancel(context.Background())
+		c := fnNewAssert().Not().CustomCtx(func(ctx context.Context, v string) error { cancel(); return ctx.Err() })
+		tAssert.Equal(t, context.Canceled, c.CheckCtx(ctx, "ab"))
+	})
+
+	// Composition
+	// --------------------------------
+
+	t.Run("Use", func(t *testing.T) {
+		base := Str().Named("base").Word().RunesInRange(3, 8, "{path}: from {min} to {max} runes")
+		a := Str().Named("login").Use(base).NotIn([]string{"admin", "root"})
+
+		tAssert.NoError(t, a.Check("alice"))
+		tAssert.Error(t, a.Check("admin"))
+		tAssert.Error(t, a.Check("Hello!"))
+		tAssert.Equal(t, "login: from 3 to 8 runes", a.Check("al").Error())
+		tAssert.Len(t, a.CheckAll("r0"), 2)
+
+		var ae *AssertionError
+		tAssert.ErrorAs(t, a.Check("al"), &ae)
+		tAssert.Equal(t, CodeRunesInRange, ae.Rule)
+		tAssert.Equal(t, "login", ae.Path)
+
+		// order of rules
+		errs := Str().LenMax(1).Use(Str().LenMin(5).PrefixEq("x")).LenEq(3).CheckAll("abc")
+		tAssert.Len(t, errs, 3)
+		for i, code := range []string{CodeLenMax, CodeLenMin, CodePrefixEq} {
+			tAssert.ErrorAs(t, errs[i], &ae)
+			tAssert.Equal(t, code, ae.Rule)
+		}
+
+		// further changes do not affect each other
+		base.LenMax(4)
+		tAssert.NoError(t, a.Check("alexandr"))
+		c := Str().Use(base)
+		c.Warn()
+		tAssert.Error(t, base.Check("alexandr"))
+		tAssert.NoError(t, c.Check("alexandr"))
+
+		// the given chain is not changed, even if its rules are added as is
+		src := Str().LenMax(2).ChainErrFn(func(err error) error { return fmt.Errorf("wrapped: %w", err) })
+		srcMsg := src.Check("abc").Error()
+		tAssert.Equal(t, `wrapped: length of "abc" expects to be less or equal to 2, got 3`, srcMsg)
+		g := Str()
+		g.addRules(src.assert)
+		g.addRules(src.assert)
+		tAssert.Equal(t, srcMsg, src.Check("abc").Error())
+		tAssert.Equal(t, srcMsg, g.CheckAll("abc")[1].Error())
+		g.Timeout(time.Second)
+		tAssert.Zero(t, src.rules[0].timeout)
+
+		// settings of rules and chain errors
+		errBase := errors.New("base")
+		errChain := errors.New("chain")
+		d := Str().Use(Str().LenMax(2).Err(errBase).LenMin(1).Warn().ChainErr(errChain)).ChainErr(errors.New("outer"))
+		tAssert.ErrorIs(t, d.Check("abc"), errBase)
+		tAssert.ErrorIs(t, d.Check("abc"), errChain)
+		tAssert.NoError(t, d.Check(""))
+		tAssert.ErrorIs(t, d.Validate("").Warnings, errChain)
+
+		// sensitive
+		e := Str().Use(Str().Sensitive().LenMax(2))
+		tAssert.NotContains(t, e.Check("secret").Error(), "secret")
+
+		// validators and other checkers
+		tAssert.Error(t, Str().Use(Str().LenMax(2).Freeze()).Check("abc"))
+		tAssert.Error(t, Str().Use(fnNewAssert().LenMax(2)).Check("abc"))
+		f := Str().Use(testChecker[string](func(ctx context.Context, v string) error { return errBase }))
+		tAssert.ErrorIs(t, f.Check("abc"), errBase)
+
+		// all types
+		tAssert.Error(t, Num[int]().Use(Num[int]().Positive()).Check(-1))
+		tAssert.Error(t, Time().Use(Time().NotZero()).Check(time.Time{}))
+		tAssert.Error(t, SliceCmp[[]int]().Use(SliceCmp[[]int]().Contains(1)).Check(nil))
+
+		tAssert.Panics(t, func() { Str().Use(nil) })
+		tAssert.Panics(t, func() { Str().Not().Use(Str().LenMax(1)) })
+	})
+
+	t.Run("Bail", func(t *testing.T) {
+		a := fnNewAssert().LenMin(1).Bail().LenMin(2).LenMax(3)
+
+		errs := a.CheckAll("")
+		tAssert.Len(t, errs, 1)
+		tAssert.Equal(t, `length of "" expects to be greater or equal to 1, got 0`, errs[0].Error())
+		tAssert.Len(t, a.CheckAll("a"), 1)
+		tAssert.Len(t, a.CheckAll("abcd"), 1)
+		tAssert.Len(t, a.Parallel(3).CheckAll(""), 1)
+
+		tAssert.Panics(t, func() { fnNewAssert().Bail() })
+	})
+
+	t.Run("DependsOn", func(t *testing.T) {
+		fnCustom := func(v string) error { return errors.New("custom") }
+
+		// any
+		a := fnNewAssert().LenMin(2).LenMax(3).Custom(fnCustom).DependsOn()
+		tAssert.Len(t, a.CheckAll("a"), 1)
+		tAssert.Len(t, a.CheckAll("ab"), 1)
+		tAssert.Equal(t, "custom", a.CheckAll("ab")[0].Error())
+
+		// codes
+		a = fnNewAssert().LenMin(2).Custom(fnCustom).LenMax(1).DependsOn(CodeLenMin)
+		tAssert.Len(t, a.CheckAll("a"), 2)
+		tAssert.Len(t, a.CheckAll("abc"), 2)
+		a = fnNewAssert().LenMin(2).Custom(fnCustom).LenMax(1).DependsOn(CodeLenMax, CodeLenEq)
+		tAssert.Len(t, a.CheckAll("a"), 2)
+
+		// codes of aliases
+		b := Str().NotEmpty().Custom(fnCustom).DependsOn(CodeNotEmpty)
+		tAssert.Len(t, b.CheckAll(""), 1)
+		tAssert.Len(t, b.Parallel(2).CheckAll(""), 1)
+		tAssert.Len(t, b.CheckAll("a"), 1)
+		tAssert.Len(t, Str().NotEmpty().Custom(fnCustom).DependsOn(CodeNotEq).CheckAll(""), 1)
+
+		// skipped rules are not failed
+		a = fnNewAssert().Custom(fnCustom).LenMin(5).DependsOn().LenMax(1).DependsOn(CodeLenMin)
+		tAssert.Len(t, a.CheckAll("abc"), 2)
+		tAssert.Len(t, a.Parallel(2).CheckAll("abc"), 2)
+
+		// Check is not affected
+		tAssert.Equal(t, "custom", a.Check("abc").Error())
+
+		tAssert.Panics(t, func() { fnNewAssert().DependsOn() })
+	})
+
+	t.Run("MaxErrors", func(t *testing.T) {
+		a := fnNewAssert().LenMin(5).LenMax(1).LenEq(3).MaxErrors(2)
+		errs := a.CheckAll("ab")
+		tAssert.Len(t, errs, 2)
+		tAssert.Contains(t, errs[1].Error(), "less or equal to 1")
+		tAssert.Len(t, a.Parallel(3).CheckAll("ab"), 2)
+		tAssert.Len(t, a.MaxErrors(0).CheckAll("ab"), 3)
+		tAssert.Len(t, a.MaxErrors(1).CheckAllErr("ab").(ValidationErrors), 1)
+
+		tAssert.Panics(t, func() { fnNewAssert().MaxErrors(-1) })
+	})
+
+	// Errors
+	// --------------------------------
+
+	t.Run("Err", func(t *testing.T) {
+		errArg := errors.New("invalid argument")
+		errLong := errors.New("too long")
+
+		a := fnNewAssert().Named("name").LenMin(2).LenMax(3).Err(errLong).ChainErr(errArg)
+
+		err := a.Check("abcd")
+		tAssert.Equal(t, "name: length of \"abcd\" expects to be less or equal to 3, got 4", err.Error())
+		tAssert.ErrorIs(t, err, errArg)
+		tAssert.ErrorIs(t, err, errLong)
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeLenMax, ae.Rule)
+
+		err = a.Check("a", "bad {path}")
+		tAssert.Equal(t, "bad name", err.Error())
+		tAssert.ErrorIs(t, err, errArg)
+		tAssert.NotErrorIs(t, err, errLong)
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeLenMin, ae.Rule)
+
+		err = a.CheckAllErr("")
+		tAssert.ErrorIs(t, err, errArg)
+		tAssert.NotErrorIs(t, err, errLong)
+		tAssert.ErrorIs(t, a.CheckAllErr("abcd"), errLong)
+
+		tAssert.NoError(t, a.Check("abc"))
+		tAssert.Panics(t, func() { fnNewAssert().Err(errArg) })
+		tAssert.Panics(t, func() { fnNewAssert().LenMax(3).Err(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().ChainErr(nil) })
+
+		// must
+		tAssert.True(t, func() (is bool) {
+			defer func() { is = errors.Is(recover().(error), errArg) }()
+			a.Must("abcd")
+			return false
+		}())
+
+		// nested chain
+		inner := fnNewAssert().Named("inner").LenMax(3).Err(errLong)
+		outer := fnNewAssert().Named("outer").Custom(func(v string) error { return inner.Check(v) }).Sensitive()
+		err = outer.Check("abcd")
+		tAssert.ErrorIs(t, err, errLong)
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "outer.inner: length of [redacted] expects to be less or equal to 3, got 4", err.Error())
+	})
+
+	t.Run("ErrFn", func(t *testing.T) {
+		errArg := errors.New("invalid argument")
+		fnWrap := func(prefix string) func(err error) error {
+			return func(err error) error { return fmt.Errorf("%s: %w", prefix, err) }
+		}
+
+		a := fnNewAssert().LenMin(2).LenMax(3).ErrFn(fnWrap("rule")).ChainErrFn(fnWrap("chain")).ChainErr(errArg)
+
+		err := a.Check("abcd")
+		tAssert.Equal(t, "chain: rule: length of \"abcd\" expects to be less or equal to 3, got 4", err.Error())
+		tAssert.ErrorIs(t, err, errArg)
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "chain: length of \"a\" expects to be greater or equal to 2, got 1", a.Check("a").Error())
+
+		// nil result keeps the original error
+		a = fnNewAssert().LenMax(3).ErrFn(func(err error) error { return nil })
+		tAssert.Equal(t, "length of \"abcd\" expects to be less or equal to 3, got 4", a.Check("abcd").Error())
+
+		tAssert.Panics(t, func() { fnNewAssert().ErrFn(fnWrap("x")) })
+		tAssert.Panics(t, func() { fnNewAssert().LenMax(3).ErrFn(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().ChainErrFn(nil) })
+	})
+
+	// Name
+	// --------------------------------
+
+	t.Run("Named", func(t *testing.T) {
+		a := fnNewAssert().Named("name").LenMax(3)
+
+		err := a.Check("abcd")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "name", ae.Path)
+		tAssert.Equal(t, "name: "+ae.DefaultMsg, err.Error())
+		tAssert.Equal(t, "name: "+ae.DefaultMsg, ae.LocalizedMsg(LocaleEn))
+
+		errs := a.CheckAll("abcd")
+		tAssert.Len(t, errs, 1)
+		tAssert.Equal(t, err.Error(), errs[0].Error())
+
+		// custom messages
+		tAssert.Equal(t, "name is too long", fnNewAssert().Named("name").LenMax(3, "{path} is too long").Check("abcd").Error())
+		tAssert.Equal(t, "name: 4 > 3", fnNewAssert().Named("name").LenMax(3).Check("abcd", "{path}: {len} > {max}").Error())
+
+		// custom rule
+		rErr := errors.New("custom")
+		err = fnNewAssert().Named("name").Custom(func(v string) error { return rErr }).Check("x")
+		tAssert.Equal(t, "name: custom", err.Error())
+		tAssert.ErrorIs(t, err, rErr)
+		err = fnNewAssert().Named("name").Custom(func(v string) error { return rErr }).Check("x", "{path} is bad")
+		tAssert.Equal(t, "name is bad", err.Error())
+	})
+
+	t.Run("Named path", func(t *testing.T) {
+		type user struct{ emails []string }
+
+		fnEmail := func(i int) *testAssert {
+			return fnNewAssert().Named(fmt.Sprintf("[%d]", i)).LenMin(3, "{path} is too short")
+		}
+		a := Any[user]().Named("user").Custom(func(v user) error {
+			return SliceAny[[]string]().Named("emails").Custom(func(emails []string) error {
+				errs := ValidationErrors{}
+				for i, e := range emails {
+					if err := fnEmail(i).Check(e); err != nil {
+						errs = append(errs, err)
+					}
+				}
+				return ternary[error](len(errs) > 0, errs, nil)
+			}).Check(v.emails)
+		})
+
+		err := a.Check(user{emails: []string{"a@b.c", "x", "y"}})
+		var ves ValidationErrors
+		tAssert.True(t, errors.As(err, &ves))
+		tAssert.Len(t, ves, 2)
+
+		var ae *AssertionError
+		tAssert.True(t, errors.As(ves[1], &ae))
+		tAssert.Equal(t, "user.emails[2]", ae.Path)
+		tAssert.Equal(t, "user.emails[2] is too short", ae.Error())
+	})
+}
+
+// testChecker -- Checker implementation outside of assertion chains.
+type testChecker[T any] func(ctx context.Context, v T) error
+
+func (c testChecker[T]) CheckCtx(ctx context.Context, v T, _ ...string) error {
+	return c(ctx, v)
+}
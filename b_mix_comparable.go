@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -16,34 +17,58 @@ func newMixinComparable[A assertInterface[T], T comparable](assert A) *mixinComp
 // Eq
 // ---------------------------------------------------------------------------------------------------------------------
 
-// Eq
-//
-// Value expects to be equal to "eq".
-func (m *mixinComparable[A, T]) Eq(eq T, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinComparable[A, T]) eq(op operand[T], customErrMsg []string) A {
+	params := map[string]any{"eq": op.param()}
+	m.assert.addRuleCtx(CodeEq, params, customErrMsg, func(ctx context.Context, v T) error {
+		eq := op.getCtx(ctx)
 		if v == eq {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf("value expects to be equal to %s, got %s", fmtVal(eq), fmtVal(v)),
+			CodeEq,
+			map[string]any{"eq": eq},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
 	return m.assert
 }
 
+// Eq
+//
+// Value expects to be equal to "eq".
+func (m *mixinComparable[A, T]) Eq(eq T, customErrMsg ...string) A {
+	return m.eq(staticOperand(eq), customErrMsg)
+}
+
+// EqFn
+//
+// Works same as Eq, but "eq" is evaluated on each check.
+//
+// Panics, if "eq" is nil.
+func (m *mixinComparable[A, T]) EqFn(eq func() T, customErrMsg ...string) A {
+	if eq == nil {
+		panic(fmt.Errorf("%T.EqFn expects not nil eq", m.assert))
+	}
+
+	return m.eq(dynamicOperand(eq), customErrMsg)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // Not Eq
 // ---------------------------------------------------------------------------------------------------------------------
 
-// NotEq
-//
-// Value expects to be not equal to "notEq".
-func (m *mixinComparable[A, T]) NotEq(notEq T, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinComparable[A, T]) notEq(op operand[T], customErrMsg []string) A {
+	params := map[string]any{"notEq": op.param()}
+	m.assert.addRuleCtx(CodeNotEq, params, customErrMsg, func(ctx context.Context, v T) error {
+		notEq := op.getCtx(ctx)
 		if v == notEq {
 			return mkCheckErr(
-				fmt.Sprintf("value expects to be not equal to %s, got %s", fmtVal(notEq), fmtVal(v)),
+				CodeNotEq,
+				map[string]any{"notEq": notEq},
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -52,17 +77,34 @@ func (m *mixinComparable[A, T]) NotEq(notEq T, customErrMsg ...string) A {
 	return m.assert
 }
 
+// NotEq
+//
+// Value expects to be not equal to "notEq".
+func (m *mixinComparable[A, T]) NotEq(notEq T, customErrMsg ...string) A {
+	return m.notEq(staticOperand(notEq), customErrMsg)
+}
+
+// NotEqFn
+//
+// Works same as NotEq, but "notEq" is evaluated on each check.
+//
+// Panics, if "notEq" is nil.
+func (m *mixinComparable[A, T]) NotEqFn(notEq func() T, customErrMsg ...string) A {
+	if notEq == nil {
+		panic(fmt.Errorf("%T.NotEqFn expects not nil notEq", m.assert))
+	}
+
+	return m.notEq(dynamicOperand(notEq), customErrMsg)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // In
 // ---------------------------------------------------------------------------------------------------------------------
 
-// In
-//
-// Value expects to be equal to any of the provided elements.
-//
-// Fails check, if no elements provided.
-func (m *mixinComparable[A, T]) In(slice []T, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinComparable[A, T]) in(op operand[[]T], customErrMsg []string) A {
+	params := map[string]any{"in": op.param()}
+	m.assert.addRuleCtx(CodeIn, params, customErrMsg, func(ctx context.Context, v T) error {
+		slice := op.getCtx(ctx)
 		if len(slice) > 0 {
 			for _, sv := range slice {
 				if sv == v {
@@ -71,31 +113,56 @@ func (m *mixinComparable[A, T]) In(slice []T, customErrMsg ...string) A {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf("value expects to be in %s, got %s", fmtVal(slice), fmtVal(v)),
+			CodeIn,
+			map[string]any{"in": slice},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
 	return m.assert
 }
 
+// In
+//
+// Value expects to be equal to any of the provided elements.
+//
+// Fails check, if no elements provided.
+func (m *mixinComparable[A, T]) In(slice []T, customErrMsg ...string) A {
+	return m.in(staticOperand(slice), customErrMsg)
+}
+
+// InFn
+//
+// Works same as In, but "slice" is evaluated on each check.
+//
+// Panics, if "slice" is nil.
+func (m *mixinComparable[A, T]) InFn(slice func() []T, customErrMsg ...string) A {
+	if slice == nil {
+		panic(fmt.Errorf("%T.InFn expects not nil slice", m.assert))
+	}
+
+	return m.in(dynamicOperand(slice), customErrMsg)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // Not In
 // ---------------------------------------------------------------------------------------------------------------------
 
-// NotIn
-//
-// Value expects to be not equal to each of the provided elements.
-//
-// Passes check, if no elements provided.
-func (m *mixinComparable[A, T]) NotIn(slice []T, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinComparable[A, T]) notIn(op operand[[]T], customErrMsg []string) A {
+	params := map[string]any{"notIn": op.param()}
+	m.assert.addRuleCtx(CodeNotIn, params, customErrMsg, func(ctx context.Context, v T) error {
+		slice := op.getCtx(ctx)
 		if len(slice) == 0 {
 			return nil
 		}
 		for _, sv := range slice {
 			if sv == v {
 				return mkCheckErr(
-					fmt.Sprintf("value expects to be not in %s, got %s", fmtVal(slice), fmtVal(v)),
+					CodeNotIn,
+					map[string]any{"notIn": slice},
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -105,4 +172,26 @@ func (m *mixinComparable[A, T]) NotIn(slice []T, customErrMsg ...string) A {
 	return m.assert
 }
 
+// NotIn
+//
+// Value expects to be not equal to each of the provided elements.
+//
+// Passes check, if no elements provided.
+func (m *mixinComparable[A, T]) NotIn(slice []T, customErrMsg ...string) A {
+	return m.notIn(staticOperand(slice), customErrMsg)
+}
+
+// NotInFn
+//
+// Works same as NotIn, but "slice" is evaluated on each check.
+//
+// Panics, if "slice" is nil.
+func (m *mixinComparable[A, T]) NotInFn(slice func() []T, customErrMsg ...string) A {
+	if slice == nil {
+		panic(fmt.Errorf("%T.NotInFn expects not nil slice", m.assert))
+	}
+
+	return m.notIn(dynamicOperand(slice), customErrMsg)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
@@ -98,4 +98,60 @@ func Test_MixinComparable(t *testing.T) {
 		tAssert.Equal(t, "e3344", a.Check(33).Error())
 		tAssert.Equal(t, "e44", a.Check(44, "e44").Error())
 	})
+
+	t.Run("Fn variants", func(t *testing.T) {
+		n := 1
+		fnN := func() int { return n }
+		fnSlice := func() []int { return []int{n, n + 1} }
+
+		a := fnNewAssert().EqFn(fnN)
+		tAssert.NoError(t, a.Check(1))
+		n = 2
+		tAssert.NoError(t, a.Check(2))
+		err := a.Check(1)
+		tAssert.Equal(t, "value expects to be equal to 2, got 1", err.Error())
+		var ae *AssertionError
+		tAssert.ErrorAs(t, err, &ae)
+		tAssert.Equal(t, map[string]any{"eq": 2}, ae.Params)
+
+		b := fnNewAssert().NotEqFn(fnN)
+		tAssert.Error(t, b.Check(2))
+		tAssert.NoError(t, b.Check(1))
+
+		c := fnNewAssert().InFn(fnSlice)
+		tAssert.NoError(t, c.Check(3))
+		n = 5
+		tAssert.Error(t, c.Check(3))
+		tAssert.Equal(t, "value expects to be in []int{5, 6}, got 3", c.Check(3).Error())
+
+		d := fnNewAssert().NotInFn(fnSlice)
+		tAssert.Error(t, d.Check(6))
+		tAssert.NoError(t, d.Check(7))
+
+		// negation
+		n = 7
+		e := Num[int]().Not().EqFn(fnN)
+		tAssert.NoError(t, e.Check(1))
+		tAssert.Equal(t, `value expects not to match Eq rule (equal to 7), got 7`, e.Check(7).Error())
+		tAssert.ErrorAs(t, e.Check(7), &ae)
+		tAssert.Equal(t, map[string]any{"eq": 7, "rule": CodeEq}, ae.Params)
+
+		// operands of negated rules are evaluated once per check -- the error reports the compared value
+		calls := 0
+		fnNext := func() int { calls++; return calls }
+		err = Num[int]().Not().EqFn(fnNext).Check(1)
+		tAssert.Equal(t, `value expects not to match Eq rule (equal to 1), got 1`, err.Error())
+		tAssert.Equal(t, 1, calls)
+		tAssert.ErrorAs(t, err, &ae)
+		tAssert.Equal(t, map[string]any{"eq": 1, "rule": CodeEq}, ae.Params)
+
+		calls = 0
+		err = Num[int]().Not().InRangeFn(fnNext, func() int { return calls + 10 }).Check(5)
+		tAssert.Equal(t, `value expects not to match InRange rule (in range [1, 11]), got 5`, err.Error())
+
+		tAssert.Panics(t, func() { fnNewAssert().EqFn(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().NotEqFn(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().InFn(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().NotInFn(nil) })
+	})
 }
@@ -1,5 +1,7 @@
 package assert
 
+import "context"
+
 type mixinCustom[A assertInterface[T], T any] struct {
 	assert A
 }
@@ -12,3 +14,15 @@ func (m *mixinCustom[A, T]) Custom(check func(v T) error) A {
 	m.assert.addCheck(check)
 	return m.assert
 }
+
+// CustomCtx
+//
+// Works same as Custom, but the check takes the context of the result method --
+// e.g. for database or network calls.
+//
+// Result methods without context (Check, Must, etc.) pass context.Background().
+// See CheckCtx, Timeout.
+func (m *mixinCustom[A, T]) CustomCtx(check func(ctx context.Context, v T) error) A {
+	m.assert.addCheckCtx(check)
+	return m.assert
+}
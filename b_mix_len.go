@@ -48,18 +48,17 @@ func (m *mixinLen[A, T]) lenVal(v T) int {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinLen[A, T]) LenEq(eq int, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+	params := map[string]any{"eq": eq}
+	m.assert.addRule(CodeLenEq, params, customErrMsg, func(v T) error {
 		l := m.lenVal(v)
 		if m.lenComparable().Eq(eq).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of %s expects to be equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(eq),
-				fmtVal(l),
-			),
+			CodeLenEq,
+			params,
+			v,
+			map[string]any{"len": l},
 			customErrMsg,
 		)
 	})
@@ -75,18 +74,17 @@ func (m *mixinLen[A, T]) LenEq(eq int, customErrMsg ...string) A {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinLen[A, T]) LenNotEq(notEq int, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+	params := map[string]any{"notEq": notEq}
+	m.assert.addRule(CodeLenNotEq, params, customErrMsg, func(v T) error {
 		l := m.lenVal(v)
 		if m.lenComparable().NotEq(notEq).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of %s expects to be not equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(notEq),
-				fmtVal(l),
-			),
+			CodeLenNotEq,
+			params,
+			v,
+			map[string]any{"len": l},
 			customErrMsg,
 		)
 	})
@@ -102,18 +100,17 @@ func (m *mixinLen[A, T]) LenNotEq(notEq int, customErrMsg ...string) A {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinLen[A, T]) LenMin(min int, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+	params := map[string]any{"min": min}
+	m.assert.addRule(CodeLenMin, params, customErrMsg, func(v T) error {
 		l := m.lenVal(v)
 		if m.lenOrdered().GreaterEq(min).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of %s expects to be greater or equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(l),
-			),
+			CodeLenMin,
+			params,
+			v,
+			map[string]any{"len": l},
 			customErrMsg,
 		)
 	})
@@ -129,18 +126,17 @@ func (m *mixinLen[A, T]) LenMin(min int, customErrMsg ...string) A {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinLen[A, T]) LenMax(max int, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+	params := map[string]any{"max": max}
+	m.assert.addRule(CodeLenMax, params, customErrMsg, func(v T) error {
 		l := m.lenVal(v)
 		if m.lenOrdered().LessEq(max).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of %s expects to be less or equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeLenMax,
+			params,
+			v,
+			map[string]any{"len": l},
 			customErrMsg,
 		)
 	})
@@ -158,7 +154,8 @@ func (m *mixinLen[A, T]) LenMax(max int, customErrMsg ...string) A {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinLen[A, T]) LenInRange(min, max int, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+	params := map[string]any{"min": min, "max": max}
+	m.assert.addRule(CodeLenInRange, params, customErrMsg, func(v T) error {
 		l := m.lenVal(v)
 		if min <= max {
 			if m.lenOrdered().InRange(min, max).Check(l) == nil {
@@ -166,13 +163,10 @@ func (m *mixinLen[A, T]) LenInRange(min, max int, customErrMsg ...string) A {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of %s expects to be in range [%s, %s], got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeLenInRange,
+			params,
+			v,
+			map[string]any{"len": l},
 			customErrMsg,
 		)
 	})
@@ -190,7 +184,8 @@ func (m *mixinLen[A, T]) LenInRange(min, max int, customErrMsg ...string) A {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinLen[A, T]) LenNotInRange(min, max int, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+	params := map[string]any{"min": min, "max": max}
+	m.assert.addRule(CodeLenNotInRange, params, customErrMsg, func(v T) error {
 		if min > max {
 			return nil
 		}
@@ -199,13 +194,10 @@ func (m *mixinLen[A, T]) LenNotInRange(min, max int, customErrMsg ...string) A {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of %s expects to be not in range [%s, %s], got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeLenNotInRange,
+			params,
+			v,
+			map[string]any{"len": l},
 			customErrMsg,
 		)
 	})
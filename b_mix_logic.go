@@ -0,0 +1,362 @@
+package assert
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Checker
+//
+// Any assertion chain of values of type T -- e.g. *AString for strings or *ANumeric[int] for ints.
+//
+// Used by logical combinators to compose whole chains -- see AnyOf, OneOf, AllOf, NoneOf.
+type Checker[T any] interface {
+	CheckCtx(ctx context.Context, v T, customErrMsg ...string) error
+}
+
+// mixinLogic
+//
+// Logical combinators of assertion chains.
+//
+// Alternatives are checked with the context of the result method (see CheckCtx),
+// errors of its cancellation are returned as is.
+// Alternatives and sub-chains are copied on registration, so further changes of them do not affect the rule --
+// except for Checker implementations other than assertion chains, which are used as is (see snapshotChecker).
+// Combinators have no custom message arguments due to variadic alternatives -- use MsgFn or result methods instead.
+type mixinLogic[A assertInterface[T], T any] struct {
+	assert A
+}
+
+func newMixinLogic[A assertInterface[T], T any](assert A) *mixinLogic[A, T] {
+	return &mixinLogic[A, T]{assert: assert}
+}
+
+// altErrs
+//
+// Errors of alternatives -- the "errors" fact of combinators.
+//
+// Shown in messages as a numbered list of messages of alternatives and marshalled to JSON as ValidationErrors.
+type altErrs []error
+
+func (es altErrs) String() string {
+	b := strings.Builder{}
+	for i, e := range es {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString("(" + strconv.Itoa(i+1) + ") " + e.Error())
+	}
+	return b.String()
+}
+
+func (es altErrs) MarshalJSON() ([]byte, error) {
+	return ValidationErrors(es).MarshalJSON()
+}
+
+// checkAlts
+//
+// Checks the value against each alternative.
+// Returns indexes (starting from 1) of passed alternatives and errors of failed ones (nil for passed ones).
+func (m *mixinLogic[A, T]) checkAlts(ctx context.Context, alts []Checker[T], v T) (passed []int, errs []error, err error) {
+	errs = make([]error, len(alts))
+	for i, alt := range alts {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
+		altErr := alt.CheckCtx(ctx, v)
+		if altErr == nil {
+			passed = append(passed, i+1)
+			continue
+		}
+		if isCtxErr(ctx, altErr) {
+			return nil, nil, altErr
+		}
+		errs[i] = altErr
+	}
+	return passed, errs, nil
+}
+
+// prepareAlts
+//
+// Validates alternatives and returns their snapshots -- see snapshotChecker.
+func (m *mixinLogic[A, T]) prepareAlts(method string, alts []Checker[T]) []Checker[T] {
+	if len(alts) == 0 {
+		panic(fmt.Errorf("%T.%s expects at least one alternative", m.assert, method))
+	}
+	snapshots := make([]Checker[T], 0, len(alts))
+	for _, alt := range alts {
+		if alt == nil {
+			panic(fmt.Errorf("%T.%s expects not nil alternatives", m.assert, method))
+		}
+		snapshots = append(snapshots, snapshotChecker(alt))
+	}
+	return snapshots
+}
+
+// snapshotChecker
+//
+// Returns the independent copy of the assertion chain (see Clone), so further changes of it do not affect rules,
+// that use it. Other Checker implementations are returned as is.
+func snapshotChecker[T any](c Checker[T]) Checker[T] {
+	if a, ok := c.(interface{ clone() *assert[T] }); ok {
+		return a.clone()
+	}
+	return c
+}
+
+func failedAltErrs(errs []error) altErrs {
+	failed := make(altErrs, 0, len(errs))
+	for _, e := range errs {
+		if e != nil {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Any Of
+// ---------------------------------------------------------------------------------------------------------------------
+
+// AnyOf
+//
+// Value expects to pass any of the alternative chains -- e.g. either empty or a word of 2+ runes:
+//
+//	assert.Str().AnyOf(assert.Str().Empty(), assert.Str().Word().RunesMin(2))
+//
+// The message lists failures of all alternatives.
+//
+// Panics, if no alternatives given or any of them is nil.
+func (m *mixinLogic[A, T]) AnyOf(alts ...Checker[T]) A {
+	alts = m.prepareAlts("AnyOf", alts)
+
+	params := map[string]any{"count": len(alts)}
+	m.assert.addRuleCtx(CodeAnyOf, params, nil, func(ctx context.Context, v T) error {
+		passed, errs, err := m.checkAlts(ctx, alts, v)
+		if err != nil || len(passed) > 0 {
+			return err
+		}
+		return mkCheckErr(CodeAnyOf, params, v, map[string]any{"errors": altErrs(errs)}, nil)
+	})
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// One Of
+// ---------------------------------------------------------------------------------------------------------------------
+
+// OneOf
+//
+// Value expects to pass exactly one of the alternative chains.
+//
+// If none passed, fails with CodeOneOf and the message listing failures of all alternatives.
+// If several passed, fails with CodeOneOfMany and the "matched" fact with numbers (from 1) of passed alternatives.
+//
+// Panics, if no alternatives given or any of them is nil.
+func (m *mixinLogic[A, T]) OneOf(alts ...Checker[T]) A {
+	alts = m.prepareAlts("OneOf", alts)
+
+	params := map[string]any{"count": len(alts)}
+	m.assert.addRuleCtx(CodeOneOf, params, nil, func(ctx context.Context, v T) error {
+		passed, errs, err := m.checkAlts(ctx, alts, v)
+		if err != nil {
+			return err
+		}
+		switch len(passed) {
+		case 1:
+			return nil
+		case 0:
+			return mkCheckErr(CodeOneOf, params, v, map[string]any{"errors": altErrs(errs)}, nil)
+		default:
+			return mkCheckErr(CodeOneOfMany, params, v, map[string]any{"matched": passed}, nil)
+		}
+	})
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// All Of
+// ---------------------------------------------------------------------------------------------------------------------
+
+// AllOf
+//
+// Value expects to pass all the alternative chains -- e.g. to combine reusable chains.
+//
+// Unlike rules of a single chain, all alternatives are checked -- the message lists failures of each failed one.
+//
+// Panics, if no alternatives given or any of them is nil.
+func (m *mixinLogic[A, T]) AllOf(alts ...Checker[T]) A {
+	alts = m.prepareAlts("AllOf", alts)
+
+	params := map[string]any{"count": len(alts)}
+	m.assert.addRuleCtx(CodeAllOf, params, nil, func(ctx context.Context, v T) error {
+		passed, errs, err := m.checkAlts(ctx, alts, v)
+		if err != nil || len(passed) == len(alts) {
+			return err
+		}
+		return mkCheckErr(CodeAllOf, params, v, map[string]any{"errors": failedAltErrs(errs)}, nil)
+	})
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// None Of
+// ---------------------------------------------------------------------------------------------------------------------
+
+// NoneOf
+//
+// Value expects to fail each of the alternative chains -- negation of chains,
+// e.g. `NoneOf(assert.Str().PrefixEq("admin"))`.
+//
+// Fails with the "matched" fact with numbers (from 1) of passed alternatives.
+//
+// Panics, if no alternatives given or any of them is nil.
+func (m *mixinLogic[A, T]) NoneOf(alts ...Checker[T]) A {
+	alts = m.prepareAlts("NoneOf", alts)
+
+	params := map[string]any{"count": len(alts)}
+	m.assert.addRuleCtx(CodeNoneOf, params, nil, func(ctx context.Context, v T) error {
+		passed, _, err := m.checkAlts(ctx, alts, v)
+		if err != nil || len(passed) == 0 {
+			return err
+		}
+		return mkCheckErr(CodeNoneOf, params, v, map[string]any{"matched": passed}, nil)
+	})
+	return m.assert
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// When / Unless
+// ---------------------------------------------------------------------------------------------------------------------
+
+// addConditional
+//
+// Registers the conditional rule with the code (same as the name of its method), that checks the value against "sub",
+// if "cond" returns true. The error of "sub" is returned as is.
+//
+// CheckAll-family methods collect errors of all failed rules of "sub", if it is an assertion chain.
+func (m *mixinLogic[A, T]) addConditional(code string, cond func(ctx context.Context, v T) (bool, error), sub Checker[T]) {
+	if sub == nil {
+		panic(fmt.Errorf("%T.%s expects not nil sub-chain", m.assert, code))
+	}
+	sub = snapshotChecker(sub)
+
+	m.assert.addRuleCtx(code, nil, nil, func(ctx context.Context, v T) error {
+		ok, err := cond(ctx, v)
+		if err != nil || !ok {
+			return err
+		}
+		return sub.CheckCtx(ctx, v)
+	})
+
+	subAll, ok := sub.(interface {
+		CheckAllCtx(ctx context.Context, v T) []error
+	})
+	if !ok {
+		return
+	}
+	m.assert.setLastCheckAll(func(ctx context.Context, v T) error {
+		ok, err := cond(ctx, v)
+		if err != nil || !ok {
+			return err
+		}
+		errs := subAll.CheckAllCtx(ctx, v)
+		if len(errs) == 0 {
+			return nil
+		}
+		if last := errs[len(errs)-1]; isCtxErr(ctx, last) {
+			return last
+		}
+		return subErrs(errs)
+	})
+}
+
+// When
+//
+// Value expects to pass the "sub" chain, if "pred" returns true for it -- e.g. for value-dependent rules.
+//
+// The error of the "sub" chain is returned as is -- CheckAll-family methods return errors of all its failed rules.
+//
+// Panics, if "pred" or "sub" is nil.
+func (m *mixinLogic[A, T]) When(pred func(v T) bool, sub Checker[T]) A {
+	if pred == nil {
+		panic(fmt.Errorf("%T.When expects not nil pred", m.assert))
+	}
+
+	m.addConditional(CodeWhen, func(_ context.Context, v T) (bool, error) { return pred(v), nil }, sub)
+	return m.assert
+}
+
+// Unless
+//
+// Value expects to pass the "sub" chain, if "pred" returns false for it -- e.g. for optional values:
+//
+//	assert.Str().Unless(
+//		func(v string) bool { return v == "" },
+//		assert.Str().Word().RunesMin(2),
+//	)
+//
+// The error of the "sub" chain is returned as is -- CheckAll-family methods return errors of all its failed rules.
+//
+// Panics, if "pred" or "sub" is nil.
+func (m *mixinLogic[A, T]) Unless(pred func(v T) bool, sub Checker[T]) A {
+	if pred == nil {
+		panic(fmt.Errorf("%T.Unless expects not nil pred", m.assert))
+	}
+
+	m.addConditional(CodeUnless, func(_ context.Context, v T) (bool, error) { return !pred(v), nil }, sub)
+	return m.assert
+}
+
+// WhenPasses
+//
+// Value expects to pass the "sub" chain, if it passes the "cond" chain.
+//
+// The error of the "sub" chain is returned as is -- CheckAll-family methods return errors of all its failed rules.
+// Errors of the "cond" chain are ignored except for the cancellation of the context.
+//
+// Panics, if "cond" or "sub" is nil.
+func (m *mixinLogic[A, T]) WhenPasses(cond Checker[T], sub Checker[T]) A {
+	if cond == nil {
+		panic(fmt.Errorf("%T.WhenPasses expects not nil cond", m.assert))
+	}
+	cond = snapshotChecker(cond)
+
+	m.addConditional(CodeWhenPasses, func(ctx context.Context, v T) (bool, error) { return condPasses(ctx, cond, v) }, sub)
+	return m.assert
+}
+
+// UnlessPasses
+//
+// Value expects to pass the "sub" chain, if it fails the "cond" chain --
+// e.g. `UnlessPasses(assert.Str().Empty(), assert.Str().Word())` for optional values.
+//
+// The error of the "sub" chain is returned as is -- CheckAll-family methods return errors of all its failed rules.
+// Errors of the "cond" chain are ignored except for the cancellation of the context.
+//
+// Panics, if "cond" or "sub" is nil.
+func (m *mixinLogic[A, T]) UnlessPasses(cond Checker[T], sub Checker[T]) A {
+	if cond == nil {
+		panic(fmt.Errorf("%T.UnlessPasses expects not nil cond", m.assert))
+	}
+	cond = snapshotChecker(cond)
+
+	m.addConditional(CodeUnlessPasses, func(ctx context.Context, v T) (bool, error) {
+		ok, err := condPasses(ctx, cond, v)
+		return !ok && err == nil, err
+	}, sub)
+	return m.assert
+}
+
+// condPasses
+//
+// Returns true, if the value passes the "cond" chain, or the error of the cancellation of the context.
+func condPasses[T any](ctx context.Context, cond Checker[T], v T) (bool, error) {
+	err := cond.CheckCtx(ctx, v)
+	if err != nil && isCtxErr(ctx, err) {
+		return false, err
+	}
+	return err == nil, nil
+}
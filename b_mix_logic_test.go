@@ -0,0 +1,234 @@
+package assert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_MixinLogic(t *testing.T) {
+	type testAssert struct {
+		*assert[string]
+		*mixinLogic[*testAssert, string]
+	}
+	fnNewAssert := func() *testAssert {
+		a := new(testAssert)
+
+		*a = testAssert{
+			assert:     newAssert[string](),
+			mixinLogic: newMixinLogic[*testAssert, string](a),
+		}
+
+		return a
+	}
+
+	fnEmpty := func() *AString { return Str().Empty() }
+	fnName := func() *AString { return Str().Word().RunesMin(2) }
+
+	t.Run("AnyOf", func(t *testing.T) {
+		a := fnNewAssert().AnyOf(fnEmpty(), fnName())
+
+		for _, sucCase := range []string{"", "Bob", "Anna-Maria"} {
+			tAssert.NoError(t, a.Check(sucCase))
+		}
+		for _, errCase := range []string{"B", "B0b", " "} {
+			tAssert.Error(t, a.Check(errCase))
+		}
+
+		err := a.Check("B")
+		tAssert.Equal(t, `value expects to pass any of 2 alternatives, got "B": `+
+			`(1) value expects to be equal to "", got "B"; `+
+			`(2) runes count of "B" expects to be greater or equal to 2, got 1`, err.Error())
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeAnyOf, ae.Rule)
+		tAssert.Equal(t, map[string]any{"count": 2}, ae.Params)
+		tAssert.Len(t, ae.Facts["errors"], 2)
+
+		tAssert.Equal(t, "e1", a.Check("B", "e1").Error())
+		tAssert.NoError(t, fnNewAssert().AnyOf(fnName()).Check("Bob"))
+	})
+
+	t.Run("OneOf", func(t *testing.T) {
+		a := fnNewAssert().OneOf(Str().PrefixEq("a"), Str().SuffixEq("z"))
+
+		tAssert.NoError(t, a.Check("ab"))
+		tAssert.NoError(t, a.Check("bz"))
+
+		err := a.Check("b")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeOneOf, ae.Rule)
+		tAssert.Equal(t, `value expects to pass exactly one of 2 alternatives, got "b" passing none: `+
+			`(1) value expects to have prefix equal to "a", got "b"; `+
+			`(2) value expects to have suffix equal to "z", got "b"`, err.Error())
+
+		err = a.Check("az")
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeOneOfMany, ae.Rule)
+		tAssert.Equal(t, []int{1, 2}, ae.Facts["matched"])
+		tAssert.Equal(t, `value expects to pass exactly one of 2 alternatives, got "az" passing []int{1, 2}`, err.Error())
+	})
+
+	t.Run("AllOf", func(t *testing.T) {
+		a := fnNewAssert().AllOf(Str().PrefixEq("a"), Str().SuffixEq("z"), Str().LenMax(3))
+
+		tAssert.NoError(t, a.Check("az"))
+
+		err := a.Check("bbbb")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeAllOf, ae.Rule)
+		tAssert.Len(t, ae.Facts["errors"], 3)
+
+		err = a.Check("abz0")
+		tAssert.Equal(t, `value expects to pass all of 3 alternatives, got "abz0": `+
+			`(1) value expects to have suffix equal to "z", got "abz0"; `+
+			`(2) length of "abz0" expects to be less or equal to 3, got 4`, err.Error())
+	})
+
+	t.Run("NoneOf", func(t *testing.T) {
+		a := fnNewAssert().NoneOf(Str().PrefixEq("admin"), Str().In([]string{"root", "system"}))
+
+		tAssert.NoError(t, a.Check("bob"))
+		tAssert.Error(t, a.Check("admin1"))
+
+		err := a.Check("root")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeNoneOf, ae.Rule)
+		tAssert.Equal(t, []int{2}, ae.Facts["matched"])
+		tAssert.Equal(t, `value expects to pass none of 2 alternatives, got "root" passing []int{2}`, err.Error())
+	})
+
+	t.Run("nested and named", func(t *testing.T) {
+		a := Str().Named("name").AnyOf(
+			Str().Empty(),
+			Str().AllOf(Str().Word(), Str().NoneOf(Str().PrefixEq("admin"))),
+		)
+
+		tAssert.NoError(t, a.Check(""))
+		tAssert.NoError(t, a.Check("bob"))
+
+		err := a.Check("admin")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, "name", ae.Path)
+		tAssert.Equal(t, CodeAnyOf, ae.Rule)
+
+		bs, jErr := json.Marshal(ValidationErrors{err})
+		tAssert.NoError(t, jErr)
+		tAssert.Contains(t, string(bs), `"facts":{"errors":[{"message":"value expects to be equal to \"\", got \"admin\"","rule":"Eq"`)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		type ctxKey struct{}
+		alt := Str().CustomCtx(func(ctx context.Context, v string) error {
+			if ctx.Value(ctxKey{}) == v {
+				return errors.New("taken")
+			}
+			return nil
+		})
+		a := fnNewAssert().AllOf(alt)
+
+		tAssert.NoError(t, a.Check("bob"))
+		tAssert.Error(t, a.CheckCtx(context.WithValue(context.Background(), ctxKey{}, "bob"), "bob"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		tAssert.Equal(t, context.Canceled, a.CheckCtx(ctx, "bob"))
+
+		ctx, cancel = context.WithCancel(context.Background())
+		b := fnNewAssert().AnyOf(Str().CustomCtx(func(ctx context.Context, v string) error { cancel(); return ctx.Err() }))
+		tAssert.Equal(t, context.Canceled, b.CheckCtx(ctx, "bob"))
+	})
+
+	t.Run("all types", func(t *testing.T) {
+		tAssert.NoError(t, Num[int]().AnyOf(Num[int]().Less(0), Num[int]().Greater(10)).Check(11))
+		tAssert.Error(t, Num[int]().AnyOf(Num[int]().Less(0), Num[int]().Greater(10)).Check(5))
+		tAssert.Error(t, TimeDur().NoneOf(TimeDur().Eq(0)).Check(0))
+	})
+
+	t.Run("When, Unless", func(t *testing.T) {
+		fnIsEmpty := func(v string) bool { return v == "" }
+
+		a := fnNewAssert().Unless(fnIsEmpty, fnName())
+		tAssert.NoError(t, a.Check(""))
+		tAssert.NoError(t, a.Check("Bob"))
+		tAssert.Equal(t, `runes count of "B" expects to be greater or equal to 2, got 1`, a.Check("B").Error())
+
+		b := fnNewAssert().When(fnIsEmpty, fnName())
+		tAssert.NoError(t, b.Check("B"))
+		tAssert.Error(t, b.Check(""))
+
+		// the error of the sub-chain is returned as is
+		c := Str().Named("name").Unless(fnIsEmpty, Str().RunesMin(2, "{path}: too short")).LenMax(3)
+		err := c.Check("B")
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		tAssert.Equal(t, CodeRunesMin, ae.Rule)
+		tAssert.Equal(t, "name: too short", err.Error())
+		tAssert.Len(t, c.CheckAll("Bobby"), 1)
+
+		// CheckAll-family methods return errors of all failed rules of the sub-chain
+		d := Str().Named("code").When(func(v string) bool { return v != "" }, Str().Word().RunesMin(5).LenMax(2)).LenMin(2)
+		tAssert.Equal(t, CodeRegexp, d.Check("123").(*AssertionError).Rule)
+		errs := d.CheckAll("123")
+		tAssert.Len(t, errs, 3)
+		tAssert.Equal(t, `code: runes count of "123" expects to be greater or equal to 5, got 3`, errs[1].Error())
+		tAssert.Equal(t, `code: length of "123" expects to be less or equal to 2, got 3`, errs[2].Error())
+		tAssert.Len(t, d.CheckAll("1"), 3)
+		tAssert.Len(t, d.Validate("123").Errors, 3)
+		tAssert.Len(t, d.MaxErrors(2).CheckAll("123"), 2)
+		tAssert.Len(t, Str().When(fnIsEmpty, Str().LenMin(1).Warn().LenMin(2)).CheckAll(""), 1)
+		tAssert.Len(t, Str().When(fnIsEmpty, Str().LenMin(1).LenMin(2)).Bail().Parallel(2).LenMin(3).CheckAll(""), 2)
+
+		// message replaced by MsgFn is a single error
+		e := Str().When(fnIsEmpty, Str().LenMin(1).LenMin(2)).MsgFn(func(v string) string { return "bad" })
+		errs = e.CheckAll("")
+		tAssert.Len(t, errs, 1)
+		tAssert.EqualError(t, errs[0], "bad")
+	})
+
+	t.Run("WhenPasses, UnlessPasses", func(t *testing.T) {
+		a := fnNewAssert().UnlessPasses(fnEmpty(), fnName())
+		tAssert.NoError(t, a.Check(""))
+		tAssert.NoError(t, a.Check("Bob"))
+		tAssert.Error(t, a.Check("B"))
+
+		b := Num[int]().WhenPasses(Num[int]().Greater(100), Num[int]().Custom(func(v int) error {
+			if v%10 != 0 {
+				return errors.New("big values expect to be round")
+			}
+			return nil
+		}))
+		tAssert.NoError(t, b.Check(15))
+		tAssert.NoError(t, b.Check(150))
+		tAssert.EqualError(t, b.Check(155), "big values expect to be round")
+
+		// cancellation during the condition
+		ctx, cancel := context.WithCancel(context.Background())
+		cond := Str().CustomCtx(func(ctx context.Context, v string) error { cancel(); return ctx.Err() })
+		tAssert.Equal(t, context.Canceled, fnNewAssert().WhenPasses(cond, fnName()).CheckCtx(ctx, "B"))
+		tAssert.Equal(t, context.Canceled, fnNewAssert().UnlessPasses(cond, fnName()).CheckCtx(ctx, "B"))
+
+		// cancellation during the sub-chain
+		ctx, cancel = context.WithCancel(context.Background())
+		sub := Str().LenMin(5).CustomCtx(func(ctx context.Context, v string) error { cancel(); return ctx.Err() })
+		tAssert.Equal(t, []error{context.Canceled}, fnNewAssert().WhenPasses(Str(), sub).CheckAllCtx(ctx, "B"))
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		tAssert.Panics(t, func() { fnNewAssert().AnyOf() })
+		tAssert.Panics(t, func() { fnNewAssert().OneOf(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().AllOf(Str(), nil) })
+		tAssert.Panics(t, func() { fnNewAssert().NoneOf() })
+		tAssert.Panics(t, func() { fnNewAssert().When(nil, Str()) })
+		tAssert.Panics(t, func() { fnNewAssert().When(func(v string) bool { return true }, nil) })
+		tAssert.Panics(t, func() { fnNewAssert().Unless(nil, Str()) })
+		tAssert.Panics(t, func() { fnNewAssert().WhenPasses(nil, Str()) })
+		tAssert.Panics(t, func() { fnNewAssert().UnlessPasses(Str(), nil) })
+	})
+}
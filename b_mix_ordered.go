@@ -1,6 +1,9 @@
 package assert
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ---------------------------------------------------------------------------------------------------------------------
 
@@ -27,18 +30,19 @@ func newMixinOrdered[A assertInterface[T], T comparable](
 // Less
 // ---------------------------------------------------------------------------------------------------------------------
 
-func (m *mixinOrdered[A, T]) less(orEq bool, than T, customErrMsg []string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) less(orEq bool, op operand[T], customErrMsg []string) A {
+	code := ternary[string](orEq, CodeLessEq, CodeLess)
+	params := map[string]any{"than": op.param()}
+	m.assert.addRuleCtx(code, params, customErrMsg, func(ctx context.Context, v T) error {
+		than := op.getCtx(ctx)
 		if m.fnCmp(than, v) || (orEq && v == than) {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to be less %s %s, got %s",
-				ternary[string](orEq, "or equal to", "than"),
-				fmtVal(than),
-				fmtVal(v),
-			),
+			code,
+			map[string]any{"than": than},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -52,7 +56,20 @@ func (m *mixinOrdered[A, T]) less(orEq bool, than T, customErrMsg []string) A {
 //
 // Value expects to be less than "than".
 func (m *mixinOrdered[A, T]) Less(than T, customErrMsg ...string) A {
-	return m.less(false, than, customErrMsg)
+	return m.less(false, staticOperand(than), customErrMsg)
+}
+
+// LessFn
+//
+// Works same as Less, but "than" is evaluated on each check.
+//
+// Panics, if "than" is nil.
+func (m *mixinOrdered[A, T]) LessFn(than func() T, customErrMsg ...string) A {
+	if than == nil {
+		panic(fmt.Errorf("%T.LessFn expects not nil than", m.assert))
+	}
+
+	return m.less(false, dynamicOperand(than), customErrMsg)
 }
 
 // Less or Equal
@@ -62,15 +79,31 @@ func (m *mixinOrdered[A, T]) Less(than T, customErrMsg ...string) A {
 //
 // Value expects to be less or equal to "than".
 func (m *mixinOrdered[A, T]) LessEq(than T, customErrMsg ...string) A {
-	return m.less(true, than, customErrMsg)
+	return m.less(true, staticOperand(than), customErrMsg)
+}
+
+// LessEqFn
+//
+// Works same as LessEq, but "than" is evaluated on each check.
+//
+// Panics, if "than" is nil.
+func (m *mixinOrdered[A, T]) LessEqFn(than func() T, customErrMsg ...string) A {
+	if than == nil {
+		panic(fmt.Errorf("%T.LessEqFn expects not nil than", m.assert))
+	}
+
+	return m.less(true, dynamicOperand(than), customErrMsg)
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
 // Less Any
 // ---------------------------------------------------------------------------------------------------------------------
 
-func (m *mixinOrdered[A, T]) lessAny(orEq bool, elems []T, customErrMsg []string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) lessAny(orEq bool, op operand[[]T], customErrMsg []string) A {
+	code := ternary[string](orEq, CodeLessEqAny, CodeLessAny)
+	params := map[string]any{"elems": op.param()}
+	m.assert.addRuleCtx(code, params, customErrMsg, func(ctx context.Context, v T) error {
+		elems := op.getCtx(ctx)
 		if len(elems) == 0 {
 			return nil
 		}
@@ -80,12 +113,10 @@ func (m *mixinOrdered[A, T]) lessAny(orEq bool, elems []T, customErrMsg []string
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to be less %s any of %s, got %s",
-				ternary[string](orEq, "or equal to", "than"),
-				fmtVal(elems),
-				fmtVal(v),
-			),
+			code,
+			map[string]any{"elems": elems},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -101,7 +132,20 @@ func (m *mixinOrdered[A, T]) lessAny(orEq bool, elems []T, customErrMsg []string
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) LessAny(elems []T, customErrMsg ...string) A {
-	return m.lessAny(false, elems, customErrMsg)
+	return m.lessAny(false, staticOperand(elems), customErrMsg)
+}
+
+// LessAnyFn
+//
+// Works same as LessAny, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) LessAnyFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.LessAnyFn expects not nil elems", m.assert))
+	}
+
+	return m.lessAny(false, dynamicOperand(elems), customErrMsg)
 }
 
 // Less or Equal Any
@@ -113,27 +157,41 @@ func (m *mixinOrdered[A, T]) LessAny(elems []T, customErrMsg ...string) A {
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) LessEqAny(elems []T, customErrMsg ...string) A {
-	return m.lessAny(true, elems, customErrMsg)
+	return m.lessAny(true, staticOperand(elems), customErrMsg)
+}
+
+// LessEqAnyFn
+//
+// Works same as LessEqAny, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) LessEqAnyFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.LessEqAnyFn expects not nil elems", m.assert))
+	}
+
+	return m.lessAny(true, dynamicOperand(elems), customErrMsg)
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
 // Less Each
 // ---------------------------------------------------------------------------------------------------------------------
 
-func (m *mixinOrdered[A, T]) lessEach(orEq bool, elems []T, customErrMsg []string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) lessEach(orEq bool, op operand[[]T], customErrMsg []string) A {
+	code := ternary[string](orEq, CodeLessEqEach, CodeLessEach)
+	params := map[string]any{"elems": op.param()}
+	m.assert.addRuleCtx(code, params, customErrMsg, func(ctx context.Context, v T) error {
+		elems := op.getCtx(ctx)
 		if len(elems) == 0 {
 			return nil
 		}
 		for _, t := range elems {
 			if !(m.fnCmp(t, v) || (orEq && v == t)) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects to be less %s each of %s, got %s",
-						ternary[string](orEq, "or equal to", "than"),
-						fmtVal(elems),
-						fmtVal(v),
-					),
+					code,
+					map[string]any{"elems": elems},
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -152,7 +210,20 @@ func (m *mixinOrdered[A, T]) lessEach(orEq bool, elems []T, customErrMsg []strin
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) LessEach(elems []T, customErrMsg ...string) A {
-	return m.lessEach(false, elems, customErrMsg)
+	return m.lessEach(false, staticOperand(elems), customErrMsg)
+}
+
+// LessEachFn
+//
+// Works same as LessEach, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) LessEachFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.LessEachFn expects not nil elems", m.assert))
+	}
+
+	return m.lessEach(false, dynamicOperand(elems), customErrMsg)
 }
 
 // Less or Equal Each
@@ -164,25 +235,39 @@ func (m *mixinOrdered[A, T]) LessEach(elems []T, customErrMsg ...string) A {
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) LessEqEach(elems []T, customErrMsg ...string) A {
-	return m.lessEach(true, elems, customErrMsg)
+	return m.lessEach(true, staticOperand(elems), customErrMsg)
+}
+
+// LessEqEachFn
+//
+// Works same as LessEqEach, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) LessEqEachFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.LessEqEachFn expects not nil elems", m.assert))
+	}
+
+	return m.lessEach(true, dynamicOperand(elems), customErrMsg)
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
 // Greater
 // ---------------------------------------------------------------------------------------------------------------------
 
-func (m *mixinOrdered[A, T]) greater(orEq bool, than T, customErrMsg []string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) greater(orEq bool, op operand[T], customErrMsg []string) A {
+	code := ternary[string](orEq, CodeGreaterEq, CodeGreater)
+	params := map[string]any{"than": op.param()}
+	m.assert.addRuleCtx(code, params, customErrMsg, func(ctx context.Context, v T) error {
+		than := op.getCtx(ctx)
 		if m.fnCmp(v, than) || (orEq && v == than) {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to be greater %s %s, got %s",
-				ternary[string](orEq, "or equal to", "than"),
-				fmtVal(than),
-				fmtVal(v),
-			),
+			code,
+			map[string]any{"than": than},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -196,7 +281,20 @@ func (m *mixinOrdered[A, T]) greater(orEq bool, than T, customErrMsg []string) A
 //
 // Value expects to be greater than "than".
 func (m *mixinOrdered[A, T]) Greater(than T, customErrMsg ...string) A {
-	return m.greater(false, than, customErrMsg)
+	return m.greater(false, staticOperand(than), customErrMsg)
+}
+
+// GreaterFn
+//
+// Works same as Greater, but "than" is evaluated on each check.
+//
+// Panics, if "than" is nil.
+func (m *mixinOrdered[A, T]) GreaterFn(than func() T, customErrMsg ...string) A {
+	if than == nil {
+		panic(fmt.Errorf("%T.GreaterFn expects not nil than", m.assert))
+	}
+
+	return m.greater(false, dynamicOperand(than), customErrMsg)
 }
 
 // Greater or Equal
@@ -206,15 +304,31 @@ func (m *mixinOrdered[A, T]) Greater(than T, customErrMsg ...string) A {
 //
 // Value expects to be greater or equal to "than".
 func (m *mixinOrdered[A, T]) GreaterEq(than T, customErrMsg ...string) A {
-	return m.greater(true, than, customErrMsg)
+	return m.greater(true, staticOperand(than), customErrMsg)
+}
+
+// GreaterEqFn
+//
+// Works same as GreaterEq, but "than" is evaluated on each check.
+//
+// Panics, if "than" is nil.
+func (m *mixinOrdered[A, T]) GreaterEqFn(than func() T, customErrMsg ...string) A {
+	if than == nil {
+		panic(fmt.Errorf("%T.GreaterEqFn expects not nil than", m.assert))
+	}
+
+	return m.greater(true, dynamicOperand(than), customErrMsg)
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
 // Greater Any
 // ---------------------------------------------------------------------------------------------------------------------
 
-func (m *mixinOrdered[A, T]) greaterAny(orEq bool, elems []T, customErrMsg []string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) greaterAny(orEq bool, op operand[[]T], customErrMsg []string) A {
+	code := ternary[string](orEq, CodeGreaterEqAny, CodeGreaterAny)
+	params := map[string]any{"elems": op.param()}
+	m.assert.addRuleCtx(code, params, customErrMsg, func(ctx context.Context, v T) error {
+		elems := op.getCtx(ctx)
 		if len(elems) == 0 {
 			return nil
 		}
@@ -224,12 +338,10 @@ func (m *mixinOrdered[A, T]) greaterAny(orEq bool, elems []T, customErrMsg []str
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to be greater %s any of %s, got %s",
-				ternary[string](orEq, "or equal to", "than"),
-				fmtVal(elems),
-				fmtVal(v),
-			),
+			code,
+			map[string]any{"elems": elems},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -245,7 +357,20 @@ func (m *mixinOrdered[A, T]) greaterAny(orEq bool, elems []T, customErrMsg []str
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) GreaterAny(elems []T, customErrMsg ...string) A {
-	return m.greaterAny(false, elems, customErrMsg)
+	return m.greaterAny(false, staticOperand(elems), customErrMsg)
+}
+
+// GreaterAnyFn
+//
+// Works same as GreaterAny, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) GreaterAnyFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.GreaterAnyFn expects not nil elems", m.assert))
+	}
+
+	return m.greaterAny(false, dynamicOperand(elems), customErrMsg)
 }
 
 // Greater or Equal Any
@@ -257,27 +382,41 @@ func (m *mixinOrdered[A, T]) GreaterAny(elems []T, customErrMsg ...string) A {
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) GreaterEqAny(elems []T, customErrMsg ...string) A {
-	return m.greaterAny(true, elems, customErrMsg)
+	return m.greaterAny(true, staticOperand(elems), customErrMsg)
+}
+
+// GreaterEqAnyFn
+//
+// Works same as GreaterEqAny, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) GreaterEqAnyFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.GreaterEqAnyFn expects not nil elems", m.assert))
+	}
+
+	return m.greaterAny(true, dynamicOperand(elems), customErrMsg)
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
 // Greater Each
 // ---------------------------------------------------------------------------------------------------------------------
 
-func (m *mixinOrdered[A, T]) greaterEach(orEq bool, elems []T, customErrMsg []string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) greaterEach(orEq bool, op operand[[]T], customErrMsg []string) A {
+	code := ternary[string](orEq, CodeGreaterEqEach, CodeGreaterEach)
+	params := map[string]any{"elems": op.param()}
+	m.assert.addRuleCtx(code, params, customErrMsg, func(ctx context.Context, v T) error {
+		elems := op.getCtx(ctx)
 		if len(elems) == 0 {
 			return nil
 		}
 		for _, t := range elems {
 			if !(m.fnCmp(v, t) || (orEq && v == t)) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects to be greater %s each of %s, got %s",
-						ternary[string](orEq, "or equal to", "than"),
-						fmtVal(elems),
-						fmtVal(v),
-					),
+					code,
+					map[string]any{"elems": elems},
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -296,7 +435,20 @@ func (m *mixinOrdered[A, T]) greaterEach(orEq bool, elems []T, customErrMsg []st
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) GreaterEach(elems []T, customErrMsg ...string) A {
-	return m.greaterEach(false, elems, customErrMsg)
+	return m.greaterEach(false, staticOperand(elems), customErrMsg)
+}
+
+// GreaterEachFn
+//
+// Works same as GreaterEach, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) GreaterEachFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.GreaterEachFn expects not nil elems", m.assert))
+	}
+
+	return m.greaterEach(false, dynamicOperand(elems), customErrMsg)
 }
 
 // Greater or Equal Each
@@ -308,7 +460,20 @@ func (m *mixinOrdered[A, T]) GreaterEach(elems []T, customErrMsg ...string) A {
 //
 // Passes check, if no elements provided.
 func (m *mixinOrdered[A, T]) GreaterEqEach(elems []T, customErrMsg ...string) A {
-	return m.greaterEach(true, elems, customErrMsg)
+	return m.greaterEach(true, staticOperand(elems), customErrMsg)
+}
+
+// GreaterEqEachFn
+//
+// Works same as GreaterEqEach, but "elems" is evaluated on each check.
+//
+// Panics, if "elems" is nil.
+func (m *mixinOrdered[A, T]) GreaterEqEachFn(elems func() []T, customErrMsg ...string) A {
+	if elems == nil {
+		panic(fmt.Errorf("%T.GreaterEqEachFn expects not nil elems", m.assert))
+	}
+
+	return m.greaterEach(true, dynamicOperand(elems), customErrMsg)
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
@@ -319,44 +484,55 @@ func (m *mixinOrdered[A, T]) GreaterEqEach(elems []T, customErrMsg ...string) A
 // but won't provide any benefit to either the current library or to the client code.
 // ---------------------------------------------------------------------------------------------------------------------
 
-// In Range
-// ---------------------------------------------------------------------------------------------------------------------
-
-// InRange
-//
-// Value expects to be in range [min, max].
-//
-// Fails check, if min > max -- it works like empty range.
-func (m *mixinOrdered[A, T]) InRange(min T, max T, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+func (m *mixinOrdered[A, T]) inRange(minOp, maxOp operand[T], customErrMsg []string) A {
+	params := map[string]any{"min": minOp.param(), "max": maxOp.param()}
+	m.assert.addRuleCtx(CodeInRange, params, customErrMsg, func(ctx context.Context, v T) error {
+		min, max := minOp.getCtx(ctx), maxOp.getCtx(ctx)
 		if m.fnCmp(max, min) || min == max {
 			if (m.fnCmp(v, min) || min == v) && (m.fnCmp(max, v) || v == max) {
 				return nil
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to be in range [%s, %s], got %s",
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(v),
-			),
+			CodeInRange,
+			map[string]any{"min": min, "max": max},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
 	return m.assert
 }
 
-// Not In Range
+// In Range
 // ---------------------------------------------------------------------------------------------------------------------
 
-// NotInRange
+// InRange
 //
-// Value expects to be not in range [min, max] -- i.e. to be in ranges [PossibleMin, min) or (max, PossibleMax]
+// Value expects to be in range [min, max].
 //
-// Passes check, if min > max -- it works like empty range.
-func (m *mixinOrdered[A, T]) NotInRange(min T, max T, customErrMsg ...string) A {
-	m.assert.addCheck(func(v T) error {
+// Fails check, if min > max -- it works like empty range.
+func (m *mixinOrdered[A, T]) InRange(min T, max T, customErrMsg ...string) A {
+	return m.inRange(staticOperand(min), staticOperand(max), customErrMsg)
+}
+
+// InRangeFn
+//
+// Works same as InRange, but "min" and "max" are evaluated on each check.
+//
+// Panics, if "min" or "max" is nil.
+func (m *mixinOrdered[A, T]) InRangeFn(min func() T, max func() T, customErrMsg ...string) A {
+	if min == nil || max == nil {
+		panic(fmt.Errorf("%T.InRangeFn expects not nil min and max", m.assert))
+	}
+
+	return m.inRange(dynamicOperand(min), dynamicOperand(max), customErrMsg)
+}
+
+func (m *mixinOrdered[A, T]) notInRange(minOp, maxOp operand[T], customErrMsg []string) A {
+	params := map[string]any{"min": minOp.param(), "max": maxOp.param()}
+	m.assert.addRuleCtx(CodeNotInRange, params, customErrMsg, func(ctx context.Context, v T) error {
+		min, max := minOp.getCtx(ctx), maxOp.getCtx(ctx)
 		if m.fnCmp(min, max) {
 			return nil
 		}
@@ -364,16 +540,39 @@ func (m *mixinOrdered[A, T]) NotInRange(min T, max T, customErrMsg ...string) A
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to be not in range [%s, %s], got %s",
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(v),
-			),
+			CodeNotInRange,
+			map[string]any{"min": min, "max": max},
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
 	return m.assert
 }
 
+// Not In Range
+// ---------------------------------------------------------------------------------------------------------------------
+
+// NotInRange
+//
+// Value expects to be not in range [min, max] -- i.e. to be in ranges [PossibleMin, min) or (max, PossibleMax]
+//
+// Passes check, if min > max -- it works like empty range.
+func (m *mixinOrdered[A, T]) NotInRange(min T, max T, customErrMsg ...string) A {
+	return m.notInRange(staticOperand(min), staticOperand(max), customErrMsg)
+}
+
+// NotInRangeFn
+//
+// Works same as NotInRange, but "min" and "max" are evaluated on each check.
+//
+// Panics, if "min" or "max" is nil.
+func (m *mixinOrdered[A, T]) NotInRangeFn(min func() T, max func() T, customErrMsg ...string) A {
+	if min == nil || max == nil {
+		panic(fmt.Errorf("%T.NotInRangeFn expects not nil min and max", m.assert))
+	}
+
+	return m.notInRange(dynamicOperand(min), dynamicOperand(max), customErrMsg)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
@@ -313,4 +313,48 @@ func Test_MixinOrdered(t *testing.T) {
 		tAssert.Equal(t, "e2", a.Check(10, "e2").Error())
 		tAssert.NoError(t, a.Check(11))
 	})
+
+	// Dynamic operands
+	// --------------------------------
+
+	t.Run("Fn variants", func(t *testing.T) {
+		n := 0
+		fnN := func() int { return n }
+		fnElems := func() []int { return []int{n, n + 10} }
+		fnMax := func() int { return n + 5 }
+
+		cases := map[string][2]func() *testAssert{
+			"Less":          {func() *testAssert { return fnNewAssert().Less(n) }, func() *testAssert { return fnNewAssert().LessFn(fnN) }},
+			"LessEq":        {func() *testAssert { return fnNewAssert().LessEq(n) }, func() *testAssert { return fnNewAssert().LessEqFn(fnN) }},
+			"LessAny":       {func() *testAssert { return fnNewAssert().LessAny(fnElems()) }, func() *testAssert { return fnNewAssert().LessAnyFn(fnElems) }},
+			"LessEqAny":     {func() *testAssert { return fnNewAssert().LessEqAny(fnElems()) }, func() *testAssert { return fnNewAssert().LessEqAnyFn(fnElems) }},
+			"LessEach":      {func() *testAssert { return fnNewAssert().LessEach(fnElems()) }, func() *testAssert { return fnNewAssert().LessEachFn(fnElems) }},
+			"LessEqEach":    {func() *testAssert { return fnNewAssert().LessEqEach(fnElems()) }, func() *testAssert { return fnNewAssert().LessEqEachFn(fnElems) }},
+			"Greater":       {func() *testAssert { return fnNewAssert().Greater(n) }, func() *testAssert { return fnNewAssert().GreaterFn(fnN) }},
+			"GreaterEq":     {func() *testAssert { return fnNewAssert().GreaterEq(n) }, func() *testAssert { return fnNewAssert().GreaterEqFn(fnN) }},
+			"GreaterAny":    {func() *testAssert { return fnNewAssert().GreaterAny(fnElems()) }, func() *testAssert { return fnNewAssert().GreaterAnyFn(fnElems) }},
+			"GreaterEqAny":  {func() *testAssert { return fnNewAssert().GreaterEqAny(fnElems()) }, func() *testAssert { return fnNewAssert().GreaterEqAnyFn(fnElems) }},
+			"GreaterEach":   {func() *testAssert { return fnNewAssert().GreaterEach(fnElems()) }, func() *testAssert { return fnNewAssert().GreaterEachFn(fnElems) }},
+			"GreaterEqEach": {func() *testAssert { return fnNewAssert().GreaterEqEach(fnElems()) }, func() *testAssert { return fnNewAssert().GreaterEqEachFn(fnElems) }},
+			"InRange":       {func() *testAssert { return fnNewAssert().InRange(n, fnMax()) }, func() *testAssert { return fnNewAssert().InRangeFn(fnN, fnMax) }},
+			"NotInRange":    {func() *testAssert { return fnNewAssert().NotInRange(n, fnMax()) }, func() *testAssert { return fnNewAssert().NotInRangeFn(fnN, fnMax) }},
+		}
+		for name, c := range cases {
+			t.Run(name, func(t *testing.T) {
+				n = 0
+				dynamic := c[1]()
+				for _, n = range []int{-20, 0, 20} {
+					static := c[0]()
+					for _, v := range []int{-30, -20, -10, -5, 0, 5, 10, 15, 20, 25, 30, 40} {
+						tAssert.Equal(t, static.Check(v), dynamic.Check(v), "n=%d v=%d", n, v)
+					}
+				}
+			})
+		}
+
+		tAssert.Panics(t, func() { fnNewAssert().LessFn(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().GreaterEqAnyFn(nil) })
+		tAssert.Panics(t, func() { fnNewAssert().InRangeFn(fnN, nil) })
+		tAssert.Panics(t, func() { fnNewAssert().NotInRangeFn(nil, fnN) })
+	})
 }
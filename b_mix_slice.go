@@ -1,7 +1,5 @@
 package assert
 
-import "fmt"
-
 // #####################################################################################################################
 // SLICE TYPE
 // #####################################################################################################################
@@ -29,12 +27,15 @@ func newMixinSliceAny[A assertInterface[S], S sliceType[E], E any](assert A) *mi
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceAny[A, S, E]) Empty(customErrMsg ...string) A {
-	m.assert.addCheck(func(v S) error {
+	m.assert.addRule(CodeEmpty, nil, customErrMsg, func(v S) error {
 		if len(v) == 0 {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf("value expects to be empty, got %s", fmtVal(v)),
+			CodeEmpty,
+			nil,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -46,10 +47,13 @@ func (m *mixinSliceAny[A, S, E]) Empty(customErrMsg ...string) A {
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceAny[A, S, E]) NotEmpty(customErrMsg ...string) A {
-	m.assert.addCheck(func(v S) error {
+	m.assert.addRule(CodeNotEmpty, nil, customErrMsg, func(v S) error {
 		if len(v) == 0 {
 			return mkCheckErr(
-				fmt.Sprintf("value expects to be not empty, got %s", fmtVal(v)),
+				CodeNotEmpty,
+				nil,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -76,7 +80,8 @@ func (m *mixinSliceAny[A, S, E]) CustomElementAny(
 	conditionFn func(e E) bool,
 	customErrMsg ...string,
 ) A {
-	m.assert.addCheck(func(v S) error {
+	params := map[string]any{"condition": conditionName}
+	m.assert.addRule(CodeCustomElementAny, params, customErrMsg, func(v S) error {
 		if len(v) == 0 {
 			return nil
 		}
@@ -86,11 +91,10 @@ func (m *mixinSliceAny[A, S, E]) CustomElementAny(
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects any element to match %s condition, got none matched in %s",
-				fmtVal(conditionName),
-				fmtVal(v),
-			),
+			CodeCustomElementAny,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -104,6 +108,8 @@ func (m *mixinSliceAny[A, S, E]) CustomElementAny(
 //
 // Expects the slice with each element matched to custom condition.
 //
+// The error reports the first mismatched element and its index in the "elem" and "index" facts.
+//
 // Passes check, if the slice is empty.
 // If it confuses, just add the NotEmpty() rule to the chain.
 func (m *mixinSliceAny[A, S, E]) CustomElementEach(
@@ -111,19 +117,18 @@ func (m *mixinSliceAny[A, S, E]) CustomElementEach(
 	conditionFn func(e E) bool,
 	customErrMsg ...string,
 ) A {
-	m.assert.addCheck(func(v S) error {
+	params := map[string]any{"condition": conditionName}
+	m.assert.addRule(CodeCustomElementEach, params, customErrMsg, func(v S) error {
 		if len(v) == 0 {
 			return nil
 		}
-		for _, e := range v {
+		for i, e := range v {
 			if !conditionFn(e) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects each element to match %s condition, got at least %s not matched in %s",
-						fmtVal(conditionName),
-						fmtVal(e),
-						fmtVal(v),
-					),
+					CodeCustomElementEach,
+					params,
+					v,
+					map[string]any{"elem": e, "index": i},
 					customErrMsg,
 				)
 			}
@@ -140,6 +145,8 @@ func (m *mixinSliceAny[A, S, E]) CustomElementEach(
 //
 // Expects the slice with none element matched to custom condition.
 //
+// The error reports the first matched element and its index in the "elem" and "index" facts.
+//
 // Passes check, if the slice is empty.
 // If it confuses, just add the NotEmpty() rule to the chain.
 func (m *mixinSliceAny[A, S, E]) CustomElementNone(
@@ -147,19 +154,18 @@ func (m *mixinSliceAny[A, S, E]) CustomElementNone(
 	conditionFn func(e E) bool,
 	customErrMsg ...string,
 ) A {
-	m.assert.addCheck(func(v S) error {
+	params := map[string]any{"condition": conditionName}
+	m.assert.addRule(CodeCustomElementNone, params, customErrMsg, func(v S) error {
 		if len(v) == 0 {
 			return nil
 		}
-		for _, e := range v {
+		for i, e := range v {
 			if conditionFn(e) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects none element to match %s condition, got at least %s matched in %s",
-						fmtVal(conditionName),
-						fmtVal(e),
-						fmtVal(v),
-					),
+					CodeCustomElementNone,
+					params,
+					v,
+					map[string]any{"elem": e, "index": i},
 					customErrMsg,
 				)
 			}
@@ -191,14 +197,18 @@ func newMixinSliceCmp[A assertInterface[S], S sliceType[E], E comparable](assert
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceCmp[A, S, E]) Contains(e E, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"elem": e}
+	m.mixinSliceAny.assert.addRule(CodeContains, params, customErrMsg, func(v S) error {
 		for _, ev := range v {
 			if ev == e {
 				return nil
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf("value expects to contain %s, got %s", fmtVal(e), fmtVal(v)),
+			CodeContains,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -209,11 +219,15 @@ func (m *mixinSliceCmp[A, S, E]) Contains(e E, customErrMsg ...string) A {
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceCmp[A, S, E]) NotContains(e E, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"elem": e}
+	m.mixinSliceAny.assert.addRule(CodeNotContains, params, customErrMsg, func(v S) error {
 		for _, ev := range v {
 			if ev == e {
 				return mkCheckErr(
-					fmt.Sprintf("value expects to not contain %s, got %s", fmtVal(e), fmtVal(v)),
+					CodeNotContains,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -227,7 +241,8 @@ func (m *mixinSliceCmp[A, S, E]) NotContains(e E, customErrMsg ...string) A {
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceCmp[A, S, E]) ContainsAny(s S, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"elems": s}
+	m.mixinSliceAny.assert.addRule(CodeContainsAny, params, customErrMsg, func(v S) error {
 		mv := make(map[E]struct{}, len(v))
 		for _, ev := range v {
 			mv[ev] = struct{}{}
@@ -238,7 +253,10 @@ func (m *mixinSliceCmp[A, S, E]) ContainsAny(s S, customErrMsg ...string) A {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf("value expects to contain any of %s, got %s", fmtVal(s), fmtVal(v)),
+			CodeContainsAny,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -249,7 +267,8 @@ func (m *mixinSliceCmp[A, S, E]) ContainsAny(s S, customErrMsg ...string) A {
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceCmp[A, S, E]) ContainsEach(s S, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"elems": s}
+	m.mixinSliceAny.assert.addRule(CodeContainsEach, params, customErrMsg, func(v S) error {
 		mv := make(map[E]struct{}, len(v))
 		for _, ev := range v {
 			mv[ev] = struct{}{}
@@ -257,7 +276,10 @@ func (m *mixinSliceCmp[A, S, E]) ContainsEach(s S, customErrMsg ...string) A {
 		for _, es := range s {
 			if _, ok := mv[es]; !ok {
 				return mkCheckErr(
-					fmt.Sprintf("value expects to contain each of %s, got %s", fmtVal(s), fmtVal(v)),
+					CodeContainsEach,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -271,7 +293,8 @@ func (m *mixinSliceCmp[A, S, E]) ContainsEach(s S, customErrMsg ...string) A {
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (m *mixinSliceCmp[A, S, E]) ContainsNone(s S, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"elems": s}
+	m.mixinSliceAny.assert.addRule(CodeContainsNone, params, customErrMsg, func(v S) error {
 		mv := make(map[E]struct{}, len(v))
 		for _, ev := range v {
 			mv[ev] = struct{}{}
@@ -279,7 +302,10 @@ func (m *mixinSliceCmp[A, S, E]) ContainsNone(s S, customErrMsg ...string) A {
 		for _, es := range s {
 			if _, ok := mv[es]; ok {
 				return mkCheckErr(
-					fmt.Sprintf("value expects to contain none of %s, got %s", fmtVal(s), fmtVal(v)),
+					CodeContainsNone,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -300,7 +326,7 @@ func (m *mixinSliceCmp[A, S, E]) ContainsNone(s S, customErrMsg ...string) A {
 // Passes check, if the slice is empty.
 // If it confuses, just add the NotEmpty() rule to the chain.
 func (m *mixinSliceCmp[A, S, E]) Uniques(customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	m.mixinSliceAny.assert.addRule(CodeUniques, nil, customErrMsg, func(v S) error {
 		if len(v) == 0 {
 			return nil
 		}
@@ -308,7 +334,10 @@ func (m *mixinSliceCmp[A, S, E]) Uniques(customErrMsg ...string) A {
 		for _, e := range v {
 			if _, ok := me[e]; ok {
 				return mkCheckErr(
-					fmt.Sprintf("value expects all elements to be unique, got %s", fmtVal(v)),
+					CodeUniques,
+					nil,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -340,18 +369,17 @@ func (m *mixinSliceCmp[A, S, E]) uniquesLen(v S) int {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinSliceCmp[A, S, E]) UniquesLenEq(eq int, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"eq": eq}
+	m.mixinSliceAny.assert.addRule(CodeUniquesLenEq, params, customErrMsg, func(v S) error {
 		l := m.uniquesLen(v)
 		if eq == l {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of unique elements sub-slice of %s expects to be equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(eq),
-				fmtVal(l),
-			),
+			CodeUniquesLenEq,
+			params,
+			v,
+			map[string]any{"uniquesLen": l},
 			customErrMsg,
 		)
 	})
@@ -367,16 +395,15 @@ func (m *mixinSliceCmp[A, S, E]) UniquesLenEq(eq int, customErrMsg ...string) A
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinSliceCmp[A, S, E]) UniquesLenNotEq(notEq int, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"notEq": notEq}
+	m.mixinSliceAny.assert.addRule(CodeUniquesLenNotEq, params, customErrMsg, func(v S) error {
 		l := m.uniquesLen(v)
 		if notEq == l {
 			return mkCheckErr(
-				fmt.Sprintf(
-					"length of unique elements sub-slice of %s expects to be not equal to %s, got %s",
-					fmtVal(v),
-					fmtVal(notEq),
-					fmtVal(l),
-				),
+				CodeUniquesLenNotEq,
+				params,
+				v,
+				map[string]any{"uniquesLen": l},
 				customErrMsg,
 			)
 		}
@@ -394,18 +421,17 @@ func (m *mixinSliceCmp[A, S, E]) UniquesLenNotEq(notEq int, customErrMsg ...stri
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinSliceCmp[A, S, E]) UniquesLenMin(min int, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"min": min}
+	m.mixinSliceAny.assert.addRule(CodeUniquesLenMin, params, customErrMsg, func(v S) error {
 		l := m.uniquesLen(v)
 		if min <= l {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of unique elements sub-slice of %s expects to be greater or equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(l),
-			),
+			CodeUniquesLenMin,
+			params,
+			v,
+			map[string]any{"uniquesLen": l},
 			customErrMsg,
 		)
 	})
@@ -421,18 +447,17 @@ func (m *mixinSliceCmp[A, S, E]) UniquesLenMin(min int, customErrMsg ...string)
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinSliceCmp[A, S, E]) UniquesLenMax(max int, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"max": max}
+	m.mixinSliceAny.assert.addRule(CodeUniquesLenMax, params, customErrMsg, func(v S) error {
 		l := m.uniquesLen(v)
 		if l <= max {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of unique elements sub-slice of %s expects to be less or equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeUniquesLenMax,
+			params,
+			v,
+			map[string]any{"uniquesLen": l},
 			customErrMsg,
 		)
 	})
@@ -449,7 +474,8 @@ func (m *mixinSliceCmp[A, S, E]) UniquesLenMax(max int, customErrMsg ...string)
 // Fails check, if min > max -- it works like empty range.
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinSliceCmp[A, S, E]) UniquesLenInRange(min, max int, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"min": min, "max": max}
+	m.mixinSliceAny.assert.addRule(CodeUniquesLenInRange, params, customErrMsg, func(v S) error {
 		l := m.uniquesLen(v)
 		if min <= max {
 			if min <= l && l <= max {
@@ -457,13 +483,10 @@ func (m *mixinSliceCmp[A, S, E]) UniquesLenInRange(min, max int, customErrMsg ..
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"length of unique elements sub-slice of %s expects to be in range [%s, %s], got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeUniquesLenInRange,
+			params,
+			v,
+			map[string]any{"uniquesLen": l},
 			customErrMsg,
 		)
 	})
@@ -481,20 +504,18 @@ func (m *mixinSliceCmp[A, S, E]) UniquesLenInRange(min, max int, customErrMsg ..
 // Passes check, if min > max -- it works like empty range.
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (m *mixinSliceCmp[A, S, E]) UniquesLenNotInRange(min, max int, customErrMsg ...string) A {
-	m.mixinSliceAny.assert.addCheck(func(v S) error {
+	params := map[string]any{"min": min, "max": max}
+	m.mixinSliceAny.assert.addRule(CodeUniquesLenNotInRange, params, customErrMsg, func(v S) error {
 		if min > max {
 			return nil
 		}
 		l := m.uniquesLen(v)
 		if min <= l && l <= max {
 			return mkCheckErr(
-				fmt.Sprintf(
-					"length of unique elements sub-slice of %s expects to be not in range [%s, %s], got %s",
-					fmtVal(v),
-					fmtVal(min),
-					fmtVal(max),
-					fmtVal(l),
-				),
+				CodeUniquesLenNotInRange,
+				params,
+				v,
+				map[string]any{"uniquesLen": l},
 				customErrMsg,
 			)
 		}
@@ -118,6 +118,23 @@ func Test_MixinSliceAny(t *testing.T) {
 		tAssert.Error(t, a.Check([]anyType{e1, e3}))
 		tAssert.Error(t, a.Check([]anyType{e1, e2, e3}))
 
+		// the index of the mismatched element
+		err := a.Check([]anyType{e1, e2, e3, e3})
+		tAssert.Equal(t, 2, err.(*AssertionError).Facts["index"])
+		tAssert.Equal(
+			t,
+			"index 2",
+			fnNewAssert().CustomElementEach("e1e2FnMatch", e1e2FnMatch, "index {index}").Check([]anyType{e1, e2, e3}).Error(),
+		)
+		tAssert.Equal(
+			t,
+			`emails: value expects each element to match "short" condition, `+
+				`got at least "long-email" at index 1 not matched in []string{"a", "long-email"}`,
+			SliceAny[[]string]().Named("emails").
+				CustomElementEach("short", func(e string) bool { return len(e) < 5 }).
+				Check([]string{"a", "long-email"}).Error(),
+		)
+
 		tAssert.Equal(t, "e1", fnNewAssert().CustomElementEach("e1e2FnMatch", e1e2FnMatch, "e1").Check([]anyType{e3}).Error())
 		tAssert.Equal(t, "e2", fnNewAssert().CustomElementEach("e1e2FnMatch", e1e2FnMatch).Check([]anyType{e3}, "e2").Error())
 		tAssert.Equal(t, "e2", fnNewAssert().CustomElementEach("e1e2FnMatch", e1e2FnMatch, "e1").Check([]anyType{e3}, "e2").Error())
@@ -136,6 +153,7 @@ func Test_MixinSliceAny(t *testing.T) {
 		tAssert.Error(t, a.Check([]anyType{e1, e1}))
 		tAssert.Error(t, a.Check([]anyType{e1, e2}))
 		tAssert.Error(t, a.Check([]anyType{e2, e2, e1}))
+		tAssert.Equal(t, 2, a.Check([]anyType{e2, e2, e1}).(*AssertionError).Facts["index"])
 
 		tAssert.Equal(t, "e1", fnNewAssert().CustomElementNone("e1FnMatch", e1FnMatch, "e1").Check([]anyType{e1}).Error())
 		tAssert.Equal(t, "e2", fnNewAssert().CustomElementNone("e1FnMatch", e1FnMatch).Check([]anyType{e1}, "e2").Error())
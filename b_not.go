@@ -0,0 +1,66 @@
+package assert
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// negated
+//
+// Returns the check, that fails, if the given check of the rule passes, and passes otherwise -- see Not.
+//
+// Errors of the cancellation of the context are returned as is.
+func (r *rule[T]) negated(check func(ctx context.Context, v T) error) func(ctx context.Context, v T) error {
+	return func(ctx context.Context, v T) error {
+		// dynamic operands of the rule are evaluated once -- the error reports the compared values
+		opCtx := ctx
+		if hasDynamicParams(r.params) {
+			opCtx = withOperandValues(ctx)
+		}
+
+		err := check(opCtx, v)
+		if err != nil {
+			if isCtxErr(ctx, err) {
+				return err
+			}
+			return nil
+		}
+
+		params := evalParamsCtx(opCtx, r.params)
+		if params == nil {
+			params = make(map[string]any, 1)
+		}
+		params["rule"] = r.code
+		expected := ruleExpectation{name: ternary(r.name != "", r.name, r.code), code: r.code, params: params}
+		return mkCheckErr(CodeNot, params, v, map[string]any{"expected": expected}, r.customMsg)
+	}
+}
+
+// ruleExpectation
+//
+// Expectation of the negated rule -- the "expected" fact of CodeNot errors.
+//
+// Shown in messages as the name of the rule with its summary (see Describe), e.g. `Eq rule (equal to 7)`,
+// with parameters formatted by the formatter of the message, and marshalled to JSON as the string.
+type ruleExpectation struct {
+	name   string
+	code   string
+	params map[string]any
+}
+
+func (e ruleExpectation) render(args MessageArgs) string {
+	summary := renderMsgTemplate(
+		ruleSummaryTpl(e.name, e.code),
+		MessageArgs{Params: e.params, formatter: args.formatter},
+		nil,
+	)
+	return e.name + " rule (" + summary + ")"
+}
+
+func (e ruleExpectation) String() string {
+	return e.render(MessageArgs{})
+}
+
+func (e ruleExpectation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
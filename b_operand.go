@@ -0,0 +1,148 @@
+package assert
+
+import (
+	"context"
+	"sync"
+)
+
+// operand
+//
+// Operand of a rule -- the value given on the rule registration or the function,
+// that is evaluated on each check (see rules with "Fn" suffix, e.g. LessEqFn).
+type operand[T any] struct {
+	v T
+	// fn -- pointer to the function, so it identifies the operand in operandValues.
+	fn *func() T
+}
+
+func staticOperand[T any](v T) operand[T] {
+	return operand[T]{v: v}
+}
+
+func dynamicOperand[T any](fn func() T) operand[T] {
+	return operand[T]{fn: &fn}
+}
+
+// get
+//
+// Returns the value of the operand -- evaluates the function, if the operand is dynamic.
+func (o operand[T]) get() T {
+	if o.fn != nil {
+		return (*o.fn)()
+	}
+	return o.v
+}
+
+// getCtx
+//
+// Works same as get, but evaluates the function at most once per the context with operandValues, if any --
+// see withOperandValues.
+func (o operand[T]) getCtx(ctx context.Context) T {
+	if o.fn == nil {
+		return o.v
+	}
+	vals, ok := ctx.Value(operandValuesKey{}).(*operandValues)
+	if !ok {
+		return o.get()
+	}
+	return vals.get(o.fn, func() any { return o.get() }).(T)
+}
+
+// param
+//
+// Returns the value of the operand for parameters of the rule --
+// the operand itself, if it is dynamic, since the value is unknown until the check.
+func (o operand[T]) param() any {
+	if o.fn != nil {
+		return o
+	}
+	return o.v
+}
+
+// evalParam
+//
+// See dynamicParam.
+func (o operand[T]) evalParam(ctx context.Context) any {
+	return o.getCtx(ctx)
+}
+
+// dynamicParam
+//
+// Parameter of the rule, that is evaluated on each check.
+type dynamicParam interface {
+	evalParam(ctx context.Context) any
+}
+
+// hasDynamicParams
+//
+// Returns true, if any of parameters of the rule is evaluated on each check -- see dynamicParam.
+func hasDynamicParams(params map[string]any) bool {
+	for _, p := range params {
+		if _, ok := p.(dynamicParam); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// evalParams
+//
+// Returns the copy of parameters of the rule with evaluated dynamic ones.
+func evalParams(params map[string]any) map[string]any {
+	return evalParamsCtx(context.Background(), params)
+}
+
+// evalParamsCtx
+//
+// Works same as evalParams, but reuses values of dynamic parameters evaluated by the check with the context,
+// if any -- see withOperandValues.
+func evalParamsCtx(ctx context.Context, params map[string]any) map[string]any {
+	if params == nil {
+		return nil
+	}
+	evaluated := make(map[string]any, len(params))
+	for name, p := range params {
+		if dp, ok := p.(dynamicParam); ok {
+			p = dp.evalParam(ctx)
+		}
+		evaluated[name] = p
+	}
+	return evaluated
+}
+
+// operandValues
+//
+// Values of dynamic operands evaluated during a check -- so the rule and its error use same values,
+// even if functions of operands return different ones on each call (see Not).
+type operandValues struct {
+	mu   sync.Mutex
+	vals map[any]any
+}
+
+type operandValuesKey struct{}
+
+// withOperandValues
+//
+// Returns the context, that keeps values of dynamic operands evaluated with it -- see operand.getCtx.
+// The given context is returned as is, if it already keeps them (e.g. negated rules in Explain).
+func withOperandValues(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(operandValuesKey{}).(*operandValues); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, operandValuesKey{}, &operandValues{vals: make(map[any]any, 1)})
+}
+
+// get
+//
+// Returns the value of the operand by its key -- evaluates it on the first call.
+func (ov *operandValues) get(key any, eval func() any) any {
+	ov.mu.Lock()
+	defer ov.mu.Unlock()
+
+	if v, ok := ov.vals[key]; ok {
+		return v
+	}
+	v := eval()
+	ov.vals[key] = v
+	return v
+}
@@ -0,0 +1,90 @@
+package assert
+
+import (
+	"context"
+	"sync"
+)
+
+// checkAllParallel
+//
+// Works same as the sequential checkAll, but runs checks in parallel with the limited number of workers.
+//
+// Errors are collected in the order of rules -- same as in the sequential run, see errCollector.
+// Dependent rules (see DependsOn) and rules after bail-out points (see Bail) wait for preceding checks
+// and are not run, if their dependencies fail or the bail-out point is reached.
+// Other rules may run, even if their errors are not collected (e.g. after the max number of errors).
+//
+// On cancellation of the context new checks are not started,
+// errors of completed preceding checks are returned followed by the error of the context.
+// Panics of checks, which errors are collected, are propagated to the caller.
+func (a *assert[T]) checkAllParallel(ctx context.Context, v T, warnings bool) (errs []error, warns []error) {
+	type result struct {
+		done     bool
+		err      error
+		panicked bool
+		p        any
+	}
+	results := make([]result, len(a.rules))
+
+	c := a.newErrCollector(ctx, warnings)
+	next := 0
+	collect := func(until int) {
+		for ; next < until && !c.stopped; next++ {
+			if c.skips(a.rules[next]) {
+				continue
+			}
+			res := results[next]
+			if res.panicked {
+				panic(res.p)
+			}
+			if !res.done {
+				c.collect(next, ctx.Err())
+				continue
+			}
+			c.collect(next, res.err)
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, a.workers)
+	bail := false
+	for i, r := range a.rules {
+		// preceding checks have to complete to decide, if the rule is run
+		if r.dependent || bail {
+			wg.Wait()
+			collect(i)
+			bail = false
+		}
+		if c.stopped {
+			break
+		}
+		if c.skips(r) {
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		bail = r.bail
+		wg.Add(1)
+		go func(i int, r *rule[T]) {
+			defer func() {
+				if p := recover(); p != nil {
+					results[i] = result{done: true, panicked: true, p: p}
+				}
+				<-sem
+				wg.Done()
+			}()
+			results[i] = result{done: true, err: r.runAll(ctx, v)}
+		}(i, r)
+	}
+	wg.Wait()
+	collect(len(a.rules))
+
+	return c.errs, c.warns
+}
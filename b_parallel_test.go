@@ -0,0 +1,191 @@
+package assert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	tAssert "github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_Parallel(t *testing.T) {
+	fnSlow := func(d time.Duration, err error) func(v int) error {
+		return func(v int) error {
+			time.Sleep(d)
+			return err
+		}
+	}
+
+	t.Run("order", func(t *testing.T) {
+		err1, err3 := errors.New("1"), errors.New("3")
+		a := Num[int]().Named("n").
+			Custom(fnSlow(30*time.Millisecond, err1)).
+			Custom(fnSlow(0, nil)).
+			Custom(fnSlow(10*time.Millisecond, err3)).
+			Eq(1).
+			Parallel(3)
+
+		seq := Num[int]().Named("n").
+			Custom(fnSlow(0, err1)).
+			Custom(fnSlow(0, nil)).
+			Custom(fnSlow(0, err3)).
+			Eq(1)
+
+		tAssert.Equal(t, seq.CheckAll(2), a.CheckAll(2))
+		tAssert.Equal(t, seq.CheckAllErr(2), a.CheckAllErr(2))
+		tAssert.Equal(t, "n: 1", a.Check(2).Error())
+	})
+
+	t.Run("workers limit", func(t *testing.T) {
+		var running, maxRunning int32
+		fn := func(v int) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		}
+
+		a := Num[int]().Parallel(2)
+		for i := 0; i < 8; i++ {
+			a.Custom(fn)
+		}
+		tAssert.Empty(t, a.CheckAll(0))
+		tAssert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+	})
+
+	// speedup is measured by benchmarks -- see Benchmark_CheckAll_SlowChecks
+	t.Run("same as sequential", func(t *testing.T) {
+		a, seq := Num[int]().Named("n"), Num[int]().Named("n")
+		for i := 0; i < 4; i++ {
+			err := fmt.Errorf("%d", i)
+			a.Custom(fnSlow(time.Duration(4-i)*time.Millisecond, err)).Less(i)
+			seq.Custom(fnSlow(0, err)).Less(i)
+		}
+		a.Parallel(4)
+
+		tAssert.Equal(t, seq.CheckAll(2), a.CheckAll(2))
+		tAssert.Equal(t, seq.CheckAllErr(2), a.CheckAllErr(2))
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		errA := errors.New("a")
+		var started int32
+		a := Num[int]().
+			CustomCtx(func(ctx context.Context, v int) error { atomic.AddInt32(&started, 1); return errA }).
+			CustomCtx(func(ctx context.Context, v int) error { atomic.AddInt32(&started, 1); cancel(); return ctx.Err() }).
+			CustomCtx(func(ctx context.Context, v int) error { atomic.AddInt32(&started, 1); return nil }).
+			Parallel(1)
+		tAssert.Equal(t, []error{errA, context.Canceled}, a.CheckAllCtx(ctx, 0))
+		tAssert.Equal(t, int32(2), started)
+
+		// worker slots are taken, so next rules are not started after cancellation
+		ctx, cancel = context.WithCancel(context.Background())
+		started = 0
+		a = Num[int]().
+			CustomCtx(func(ctx context.Context, v int) error { atomic.AddInt32(&started, 1); return errA }).
+			CustomCtx(func(ctx context.Context, v int) error {
+				atomic.AddInt32(&started, 1)
+				cancel()
+				<-ctx.Done()
+				return ctx.Err()
+			}).
+			CustomCtx(func(ctx context.Context, v int) error { atomic.AddInt32(&started, 1); return nil }).
+			Parallel(2)
+		errs := a.CheckAllCtx(ctx, 0)
+		tAssert.Equal(t, errA, errs[0])
+		tAssert.ErrorIs(t, errs[len(errs)-1], context.Canceled)
+
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+		tAssert.Equal(t, []error{context.Canceled}, Num[int]().Eq(1).Eq(2).Parallel(2).CheckAllCtx(ctx, 0))
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		a := Num[int]().Custom(func(v int) error { panic("boom") }).Eq(1).Parallel(2)
+		tAssert.PanicsWithValue(t, "boom", func() { a.CheckAll(0) })
+	})
+
+	t.Run("dependencies", func(t *testing.T) {
+		var ran int32
+		fnDeref := func(v *int) error {
+			atomic.AddInt32(&ran, 1)
+			if *v < 0 {
+				return errors.New("negative")
+			}
+			return nil
+		}
+
+		a := Any[*int]().NotNilDeep().Bail().Custom(fnDeref).DependsOn().Parallel(2)
+		tAssert.Len(t, a.CheckAll(nil), 1)
+		tAssert.Equal(t, int32(0), atomic.LoadInt32(&ran))
+
+		// dependent rules
+		fnSlowPtr := func(d time.Duration, err error) func(v *int) error {
+			return func(v *int) error { return fnSlow(d, err)(0) }
+		}
+		b := Any[*int]().
+			Custom(fnSlowPtr(10*time.Millisecond, errors.New("slow"))).
+			NotNilDeep().
+			Custom(fnDeref).DependsOn(CodeNotNilDeep).
+			Custom(fnSlowPtr(0, nil)).
+			Parallel(4)
+		tAssert.Len(t, b.CheckAll(nil), 2)
+		tAssert.Equal(t, int32(0), atomic.LoadInt32(&ran))
+
+		// rules after bail-out points
+		c := Any[*int]().NotNilDeep().Bail().Custom(fnDeref).Custom(fnDeref).Parallel(2)
+		tAssert.Len(t, c.CheckAll(nil), 1)
+		tAssert.Equal(t, int32(0), atomic.LoadInt32(&ran))
+
+		n := -1
+		tAssert.Len(t, c.CheckAll(&n), 2)
+		tAssert.Equal(t, int32(2), atomic.LoadInt32(&ran))
+	})
+
+	t.Run("invalid workers", func(t *testing.T) {
+		tAssert.Panics(t, func() { Num[int]().Parallel(0) })
+		tAssert.Len(t, Num[int]().Eq(1).Eq(2).Parallel(1).CheckAll(0), 2)
+	})
+}
+
+func Benchmark_CheckAll_SlowChecks(b *testing.B) {
+	fnSlow := func(v int) error {
+		time.Sleep(time.Millisecond)
+		return nil
+	}
+
+	for _, workers := range []int{1, 2, 4, 8} {
+		a := Num[int]()
+		for i := 0; i < 8; i++ {
+			a.Custom(fnSlow)
+		}
+		a.Parallel(workers)
+
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = a.CheckAll(i)
+			}
+		})
+	}
+}
+
+func Benchmark_CheckAll_FastChecks(b *testing.B) {
+	for _, workers := range []int{1, 4} {
+		a := Num[int]().Greater(0).Less(100).NotEq(50).InRange(1, 99).Parallel(workers)
+
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = a.CheckAll(i)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package assert
+
+// Severity
+//
+// Severity of a rule -- see Warn.
+type Severity int
+
+const (
+	// SeverityError -- failure of the rule is an error. Used by default.
+	SeverityError Severity = iota
+	// SeverityWarning -- failure of the rule is an advisory warning, that does not fail the validation.
+	SeverityWarning
+)
+
+// String
+//
+// Returns the name of the severity -- "error" or "warning".
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// Result
+//
+// Result of Validate -- errors and warnings of failed rules separately, e.g. for forms,
+// which show warnings without blocking submission.
+type Result struct {
+	// Errors -- errors of failed rules with SeverityError. Nil, if there are no errors.
+	Errors ValidationErrors `json:"errors,omitempty"`
+
+	// Warnings -- errors of failed rules with SeverityWarning. Nil, if there are no warnings.
+	Warnings ValidationErrors `json:"warnings,omitempty"`
+}
+
+// Valid
+//
+// Returns true, if there are no errors. Warnings do not affect validity.
+func (r *Result) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// HasWarnings
+//
+// Returns true, if there are warnings.
+func (r *Result) HasWarnings() bool {
+	return len(r.Warnings) > 0
+}
+
+// Err
+//
+// Returns errors as ValidationErrors or nil, if there are no errors -- same as CheckAllErr.
+func (r *Result) Err() error {
+	if r.Valid() {
+		return nil
+	}
+	return r.Errors
+}
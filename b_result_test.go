@@ -0,0 +1,83 @@
+package assert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Result(t *testing.T) {
+	fnPassword := func() *AString {
+		return Str().Named("password").
+			RunesMin(8).
+			RunesMin(12, "{path}: at least {min} characters are recommended").Warn()
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		res := fnPassword().Validate("secret")
+		tAssert.False(t, res.Valid())
+		tAssert.True(t, res.HasWarnings())
+		tAssert.Len(t, res.Errors, 1)
+		tAssert.Len(t, res.Warnings, 1)
+		tAssert.Equal(t, res.Errors, res.Err())
+
+		res = fnPassword().Validate("password")
+		tAssert.True(t, res.Valid())
+		tAssert.True(t, res.HasWarnings())
+		tAssert.Nil(t, res.Errors)
+		tAssert.NoError(t, res.Err())
+		tAssert.Equal(t, "password: at least 12 characters are recommended", res.Warnings.Error())
+		var ae *AssertionError
+		tAssert.True(t, errors.As(res.Warnings, &ae))
+		tAssert.Equal(t, CodeRunesMin, ae.Rule)
+
+		res = fnPassword().Validate("long-password")
+		tAssert.True(t, res.Valid())
+		tAssert.False(t, res.HasWarnings())
+
+		// both
+		res = Str().LenMin(3).LenMin(5).Warn().Validate("a")
+		tAssert.Len(t, res.Errors, 1)
+		tAssert.Len(t, res.Warnings, 1)
+		tAssert.Equal(t, res, Str().LenMin(3).LenMin(5).Warn().Parallel(2).Validate("a"))
+	})
+
+	t.Run("other results ignore warnings", func(t *testing.T) {
+		a := fnPassword()
+		tAssert.NoError(t, a.Check("password"))
+		tAssert.Empty(t, a.CheckAll("password"))
+		tAssert.NoError(t, a.CheckAllErr("password"))
+		tAssert.NotPanics(t, func() { a.Must("password") })
+		tAssert.NotPanics(t, func() { a.MustAll("password") })
+		tAssert.Empty(t, a.Parallel(2).CheckAll("password"))
+
+		// warnings do not bail or count as errors
+		b := Str().LenMin(5).Warn().Bail().LenMax(1).LenEq(2).MaxErrors(1)
+		res := b.Validate("abc")
+		tAssert.Len(t, res.Warnings, 1)
+		tAssert.Len(t, res.Errors, 1)
+	})
+
+	t.Run("ValidateCtx", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		res := fnPassword().ValidateCtx(ctx, "password")
+		tAssert.Equal(t, ValidationErrors{context.Canceled}, res.Errors)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		bs, err := json.Marshal(fnPassword().Validate("password"))
+		tAssert.NoError(t, err)
+		tAssert.Contains(t, string(bs), `{"warnings":[{"message":"password: at least 12 characters are recommended"`)
+		tAssert.NotContains(t, string(bs), `"errors"`)
+	})
+
+	t.Run("Severity", func(t *testing.T) {
+		tAssert.Equal(t, "error", SeverityError.String())
+		tAssert.Equal(t, "warning", SeverityWarning.String())
+		tAssert.Equal(t, "unknown", Severity(42).String())
+		tAssert.Panics(t, func() { Str().Warn() })
+	})
+}
@@ -0,0 +1,349 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JSONSchemaDialect -- dialect of schemas returned by JSONSchema methods (JSON Schema 2020-12).
+//
+// It is the default dialect of OpenAPI 3.1, so schemas can be used in API specs as is.
+// Set it as the "$schema" keyword to use a schema as a standalone document.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// SchemaExtRules -- extension keyword of schemas with rules, that JSON Schema can't express -- see Schema.
+const SchemaExtRules = "x-assert-rules"
+
+// Schema
+//
+// JSON Schema fragment equivalent to an assertion chain -- see JSONSchema methods of assertions:
+//
+//	assert.Str().Word().RunesMax(32).JSONSchema()
+//	// {"type": "string", "pattern": "^[A-Za-z](-?[A-Za-z]+)*$", "maxLength": 32}
+//
+// Rules are converted to keywords (minLength, maxLength, pattern, enum, const, minimum, exclusiveMaximum,
+// uniqueItems, minItems, contains, etc.), negated rules (see Not) -- to the "not" keyword.
+// Repeated keywords of bounds keep the tightest value, other repeated keywords are added to "allOf"
+// (e.g. several patterns).
+//
+// Rules, that JSON Schema can't express, are listed in the SchemaExtRules extension keyword (see RuleInfo) --
+// custom rules, logical combinators, conditional rules, rules with operands evaluated on each check
+// ("Fn" variants, their current values are listed), rules of length of strings in bytes (use Runes-rules
+// for minLength and maxLength), etc.
+// Warnings (see Warn) are not included.
+//
+// Patterns of Regexp rules are included as is -- the syntax of Go differs from ECMA-262 in some details.
+type Schema map[string]any
+
+// merge
+//
+// Adds keywords of the fragment -- see Schema.
+func (s Schema) merge(frag Schema) {
+	keys := make([]string, 0, len(frag))
+	for k := range frag {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := frag[k]
+		old, ok := s[k]
+		if !ok {
+			s[k] = v
+			continue
+		}
+		if lintEqual(old, v) {
+			continue
+		}
+		switch k {
+		case "allOf":
+			s[k] = append(old.([]any), v.([]any)...)
+			continue
+		case "minimum", "exclusiveMinimum", "minLength", "minItems":
+			if c, ok := lintCompare(v, old); ok {
+				s[k] = ternary(c > 0, v, old)
+				continue
+			}
+		case "maximum", "exclusiveMaximum", "maxLength", "maxItems":
+			if c, ok := lintCompare(v, old); ok {
+				s[k] = ternary(c < 0, v, old)
+				continue
+			}
+		}
+		allOf, _ := s["allOf"].([]any)
+		s["allOf"] = append(allOf, Schema{k: v})
+	}
+}
+
+// jsonSchema
+//
+// Returns the schema of rules of the given groups (see Groups) or of all rules, if no groups given --
+// see JSONSchema methods of assertions.
+func (a *assert[T]) jsonSchema(kind string, base Schema, groups []string) Schema {
+	s := base
+	var unsupported []RuleInfo
+	for _, r := range a.rules {
+		if r.severity == SeverityWarning || !r.isSelected(groups) {
+			continue
+		}
+
+		var frag Schema
+		if !hasDynamicParams(r.params) {
+			frag = schemaRule(kind, ternary(r.name != "", r.name, r.code), r.code, r.params)
+		}
+		if frag == nil {
+			unsupported = append(unsupported, a.ruleInfo(r, evalParams(r.params)))
+			continue
+		}
+		if r.not {
+			frag = Schema{"not": frag}
+		}
+		s.merge(frag)
+	}
+	if len(unsupported) > 0 {
+		s[SchemaExtRules] = unsupported
+	}
+	return s
+}
+
+// Kinds of checked values of schemas.
+const (
+	schemaString = "string"
+	schemaNumber = "number"
+	schemaBool   = "boolean"
+	schemaTime   = "time"
+	schemaArray  = "array"
+)
+
+// schemaOfType
+//
+// Returns the schema of values of the type -- used for elements of slices. Returns nil for unknown types.
+func schemaOfType(t reflect.Type) Schema {
+	if t == reflect.TypeOf(time.Time{}) {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Schema{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Schema{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	}
+	return nil
+}
+
+// schemaRule
+//
+// Returns the schema of the rule with the given name, code and parameters for values of the kind.
+// Returns nil, if JSON Schema can't express the rule.
+func schemaRule(kind string, name string, code string, params map[string]any) Schema {
+	switch kind {
+	case schemaString:
+		switch name {
+		case "Empty":
+			return Schema{"maxLength": 0}
+		case "NotEmpty":
+			return Schema{"minLength": 1}
+		}
+		if s := schemaStrRule(code, params); s != nil {
+			return s
+		}
+	case schemaNumber:
+		if s := schemaNumRule(code, params); s != nil {
+			return s
+		}
+	case schemaArray:
+		return schemaArrayRule(code, params)
+	}
+
+	switch code {
+	case CodeEq:
+		return Schema{"const": params["eq"]}
+	case CodeNotEq:
+		return Schema{"not": Schema{"const": params["notEq"]}}
+	case CodeIn:
+		return schemaEnum(params["in"])
+	case CodeNotIn:
+		return Schema{"not": schemaEnum(params["notIn"])}
+	}
+	return nil
+}
+
+// schemaEnum
+//
+// Returns the schema of any of elements of the slice.
+func schemaEnum(elems any) Schema {
+	if lintLen(elems) == 0 {
+		return Schema{"not": Schema{}}
+	}
+	return Schema{"enum": elems}
+}
+
+// schemaLen
+//
+// Returns the schema of rules of length with the given keywords of min and max, e.g. "minLength" and "maxLength".
+func schemaLen(code string, params map[string]any, minKey, maxKey string) Schema {
+	switch {
+	case strings.HasSuffix(code, "NotEq"):
+		return Schema{"not": Schema{minKey: params["notEq"], maxKey: params["notEq"]}}
+	case strings.HasSuffix(code, "Eq"):
+		return Schema{minKey: params["eq"], maxKey: params["eq"]}
+	case strings.HasSuffix(code, "Min"):
+		return Schema{minKey: params["min"]}
+	case strings.HasSuffix(code, "Max"):
+		return Schema{maxKey: params["max"]}
+	case strings.HasSuffix(code, "NotInRange"):
+		return Schema{"not": Schema{minKey: params["min"], maxKey: params["max"]}}
+	case strings.HasSuffix(code, "InRange"):
+		return Schema{minKey: params["min"], maxKey: params["max"]}
+	}
+	return nil
+}
+
+// schemaStrRule
+//
+// See schemaRule.
+func schemaStrRule(code string, params map[string]any) Schema {
+	// anyOf -- schema of strings matching the format of any of the given strings, e.g. "^%s" for prefixes;
+	// "empty" is the schema for the empty list of strings.
+	anyOf := func(ss any, format string, negated bool, empty Schema) Schema {
+		if lintLen(ss) == 0 {
+			return empty
+		}
+		quoted := make([]string, 0, lintLen(ss))
+		for _, s := range ss.([]string) {
+			quoted = append(quoted, regexp.QuoteMeta(s))
+		}
+		alts := quoted[0]
+		if len(quoted) > 1 {
+			alts = "(?:" + strings.Join(quoted, "|") + ")"
+		}
+		frag := Schema{"pattern": fmt.Sprintf(format, alts)}
+		return ternary(negated, Schema{"not": frag}, frag)
+	}
+	never := Schema{"not": Schema{}}
+
+	switch code {
+	case CodeRunesEq, CodeRunesNotEq, CodeRunesMin, CodeRunesMax, CodeRunesInRange, CodeRunesNotInRange:
+		return schemaLen(code, params, "minLength", "maxLength")
+	case CodeRegexp:
+		return Schema{"pattern": params["pattern"]}
+	case CodePrefixEq:
+		return anyOf([]string{params["eq"].(string)}, "^%s", false, nil)
+	case CodePrefixNotEq:
+		return anyOf([]string{params["notEq"].(string)}, "^%s", true, nil)
+	case CodePrefixIn:
+		return anyOf(params["in"], "^%s", false, never)
+	case CodePrefixNotIn:
+		return anyOf(params["notIn"], "^%s", true, Schema{})
+	case CodeSuffixEq:
+		return anyOf([]string{params["eq"].(string)}, "%s$", false, nil)
+	case CodeSuffixNotEq:
+		return anyOf([]string{params["notEq"].(string)}, "%s$", true, nil)
+	case CodeSuffixIn:
+		return anyOf(params["in"], "%s$", false, never)
+	case CodeSuffixNotIn:
+		return anyOf(params["notIn"], "%s$", true, Schema{})
+	case CodeContainsStr:
+		return anyOf([]string{params["substr"].(string)}, "%s", false, nil)
+	case CodeNotContainsStr:
+		return anyOf([]string{params["substr"].(string)}, "%s", true, nil)
+	case CodeContainsStrAny:
+		return anyOf(params["substrs"], "%s", false, Schema{})
+	case CodeContainsStrNone:
+		return anyOf(params["substrs"], "%s", true, Schema{})
+	case CodeContainsStrEach:
+		allOf := make([]any, 0, lintLen(params["substrs"]))
+		for _, s := range params["substrs"].([]string) {
+			allOf = append(allOf, anyOf([]string{s}, "%s", false, nil))
+		}
+		return ternary(len(allOf) == 0, Schema{}, Schema{"allOf": allOf})
+	}
+	return nil
+}
+
+// schemaNumRule
+//
+// See schemaRule.
+func schemaNumRule(code string, params map[string]any) Schema {
+	switch code {
+	case CodeLess:
+		return Schema{"exclusiveMaximum": params["than"]}
+	case CodeLessEq:
+		return Schema{"maximum": params["than"]}
+	case CodeGreater:
+		return Schema{"exclusiveMinimum": params["than"]}
+	case CodeGreaterEq:
+		return Schema{"minimum": params["than"]}
+	case CodeInRange:
+		return Schema{"minimum": params["min"], "maximum": params["max"]}
+	case CodeNotInRange:
+		return Schema{"not": Schema{"minimum": params["min"], "maximum": params["max"]}}
+	case CodeLessAny, CodeLessEqAny, CodeLessEach, CodeLessEqEach,
+		CodeGreaterAny, CodeGreaterEqAny, CodeGreaterEach, CodeGreaterEqEach:
+		elems := reflect.ValueOf(params["elems"])
+		if elems.Len() == 0 {
+			return Schema{}
+		}
+		less := strings.HasPrefix(code, CodeLess)
+		orEq := strings.Contains(code, "Eq")
+		key := ternary(less, ternary(orEq, "maximum", "exclusiveMaximum"), ternary(orEq, "minimum", "exclusiveMinimum"))
+
+		// "any" rules are compared with the element, that is the most favorable for the value,
+		// "each" rules -- with the least favorable one, e.g. LessAny -- with the max element.
+		useMax := less != strings.HasSuffix(code, "Each")
+		ext := elems.Index(0).Interface()
+		for i := 1; i < elems.Len(); i++ {
+			e := elems.Index(i).Interface()
+			if c, _ := lintCompare(e, ext); (useMax && c > 0) || (!useMax && c < 0) {
+				ext = e
+			}
+		}
+		return Schema{key: ext}
+	}
+	return nil
+}
+
+// schemaArrayRule
+//
+// See schemaRule.
+func schemaArrayRule(code string, params map[string]any) Schema {
+	switch code {
+	case CodeLenEq, CodeLenNotEq, CodeLenMin, CodeLenMax, CodeLenInRange, CodeLenNotInRange:
+		return schemaLen(code, params, "minItems", "maxItems")
+	case CodeEmpty:
+		return Schema{"maxItems": 0}
+	case CodeNotEmpty:
+		return Schema{"minItems": 1}
+	case CodeUniques:
+		return Schema{"uniqueItems": true}
+	case CodeContains:
+		return Schema{"contains": Schema{"const": params["elem"]}}
+	case CodeNotContains:
+		return Schema{"not": Schema{"contains": Schema{"const": params["elem"]}}}
+	case CodeContainsAny:
+		return Schema{"contains": schemaEnum(params["elems"])}
+	case CodeContainsNone:
+		if lintLen(params["elems"]) == 0 {
+			return Schema{}
+		}
+		return Schema{"not": Schema{"contains": schemaEnum(params["elems"])}}
+	case CodeContainsEach:
+		rs := reflect.ValueOf(params["elems"])
+		allOf := make([]any, 0, rs.Len())
+		for i := 0; i < rs.Len(); i++ {
+			allOf = append(allOf, Schema{"contains": Schema{"const": rs.Index(i).Interface()}})
+		}
+		return ternary(len(allOf) == 0, Schema{}, Schema{"allOf": allOf})
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package assert
+
+import (
+	"encoding/json"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_Schema(t *testing.T) {
+	fnJSON := func(s Schema) string {
+		js, err := json.Marshal(s)
+		tAssert.NoError(t, err)
+		return string(js)
+	}
+
+	t.Run("String", func(t *testing.T) {
+		tAssert.Equal(
+			t,
+			Schema{"type": "string", "pattern": StringRegexpWord.String(), "maxLength": 32},
+			Str().Word().RunesMax(32).JSONSchema(),
+		)
+		tAssert.JSONEq(
+			t,
+			`{
+				"type": "string",
+				"minLength": 1,
+				"pattern": "^(?:a\\.b|c)",
+				"not": {"pattern": "x$"},
+				"allOf": [{"pattern": "^-?\\d+(\\.\\d+)?$"}, {"pattern": "b"}, {"pattern": "c"}],
+				"enum": ["1", "2"]
+			}`,
+			fnJSON(Str().
+				NotEmpty().
+				PrefixIn([]string{"a.b", "c"}).
+				Not().SuffixEq("x").
+				Numeric().
+				ContainsStrEach([]string{"b", "c"}).
+				In([]string{"1", "2"}).
+				JSONSchema()),
+		)
+		tAssert.Equal(
+			t,
+			Schema{"type": "string", "minLength": 2, "maxLength": 3},
+			Str().RunesInRange(1, 5).RunesMin(2).RunesMax(3).JSONSchema(),
+		)
+		tAssert.Equal(t, Schema{"type": "string", "maxLength": 0}, Str().Empty().JSONSchema())
+		tAssert.Equal(t, Schema{"type": "string", "not": Schema{"const": "a"}}, Str().NotEq("a").JSONSchema())
+	})
+
+	t.Run("Numeric", func(t *testing.T) {
+		tAssert.Equal(
+			t,
+			Schema{"type": "integer", "exclusiveMinimum": 0, "maximum": 10},
+			Num[int]().Positive().LessEq(10).JSONSchema(),
+		)
+		tAssert.Equal(
+			t,
+			Schema{"type": "integer", "minimum": uint8(3), "exclusiveMaximum": uint8(10)},
+			Num[uint8]().GreaterEq(3).Less(10).JSONSchema(),
+		)
+		tAssert.Equal(t, Schema{"type": "integer", "minimum": uint(0)}, Num[uint]().JSONSchema())
+		tAssert.Equal(
+			t,
+			Schema{"type": "number", "exclusiveMaximum": 1.5, "minimum": 0.5, "not": Schema{"minimum": 1.0, "maximum": 1.2}},
+			Num[float64]().LessEach([]float64{2, 1.5}).GreaterEqAny([]float64{0.5, 1}).NotInRange(1, 1.2).JSONSchema(),
+		)
+		tAssert.Equal(t, Schema{"type": "integer", "enum": []int{1, 2}}, Num[int]().In([]int{1, 2}).JSONSchema())
+		tAssert.Equal(t, Schema{"type": "integer", "not": Schema{}}, Num[int]().In(nil).JSONSchema())
+	})
+
+	t.Run("Bool", func(t *testing.T) {
+		tAssert.Equal(t, Schema{"type": "boolean", "const": true}, Bool().True().JSONSchema())
+	})
+
+	t.Run("Time", func(t *testing.T) {
+		tAssert.JSONEq(
+			t,
+			`{
+				"type": "string",
+				"format": "date-time",
+				"not": {"const": "0001-01-01T00:00:00Z"},
+				"x-assert-rules": [
+					{"name": "Less", "code": "Less", "params": {"than": "1970-01-01T00:00:00Z"}, "summary": "< 1970-01-01T00:00:00Z"}
+				]
+			}`,
+			fnJSON(Time().NotZero().Less(time.Unix(0, 0).UTC()).JSONSchema()),
+		)
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		tAssert.JSONEq(
+			t,
+			`{
+				"type": "array",
+				"items": {"type": "string"},
+				"minItems": 1,
+				"maxItems": 3,
+				"uniqueItems": true,
+				"contains": {"const": "a"},
+				"allOf": [{"contains": {"const": "b"}}, {"contains": {"const": "c"}}],
+				"not": {"contains": {"enum": ["d"]}},
+				"x-assert-rules": [
+					{"name": "UniquesLenMin", "code": "UniquesLenMin", "params": {"min": 2}, "summary": "unique elements >= 2"}
+				]
+			}`,
+			fnJSON(SliceCmp[[]string]().
+				NotEmpty().
+				LenMax(3).
+				Uniques().
+				Contains("a").
+				ContainsEach([]string{"b", "c"}).
+				ContainsNone([]string{"d"}).
+				UniquesLenMin(2).
+				JSONSchema()),
+		)
+		tAssert.Equal(
+			t,
+			Schema{"type": "array", "maxItems": 0},
+			SliceAny[[]struct{}]().Empty().JSONSchema(),
+		)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		s := Str().
+			LenMax(5).
+			Custom(func(v string) error { return nil }).
+			EqFn(func() string { return "b" }).
+			JSONSchema()
+		tAssert.Equal(t, "string", s["type"])
+		tAssert.Len(t, s, 2)
+
+		rules := s[SchemaExtRules].([]RuleInfo)
+		tAssert.Len(t, rules, 3)
+		tAssert.Equal(t, CodeLenMax, rules[0].Code)
+		tAssert.Equal(t, CodeCustom, rules[1].Code)
+		tAssert.Equal(t, map[string]any{"eq": "b"}, rules[2].Params)
+	})
+
+	t.Run("Groups and warnings", func(t *testing.T) {
+		a := Str().RunesMax(10).RunesMin(3).Groups("create").RunesMin(1).Warn()
+		tAssert.Equal(t, Schema{"type": "string", "maxLength": 10, "minLength": 3}, a.JSONSchema())
+		tAssert.Equal(t, Schema{"type": "string", "maxLength": 10, "minLength": 3}, a.JSONSchema("create"))
+		tAssert.Equal(t, Schema{"type": "string", "maxLength": 10}, a.JSONSchema("update"))
+	})
+}
@@ -0,0 +1,93 @@
+package assert
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// Redacted
+//
+// Replacement of a sensitive value in messages and errors -- see Sensitive.
+type Redacted string
+
+// String
+//
+// Returns the replacement as is -- without quotes in messages.
+func (r Redacted) String() string {
+	return string(r)
+}
+
+// RedactFunc
+//
+// Returns the replacement of the sensitive value.
+type RedactFunc func(v any) Redacted
+
+// RedactMask
+//
+// Replaces any sensitive value with the "[redacted]" mask. Used by default.
+func RedactMask(v any) Redacted {
+	return "[redacted]"
+}
+
+// RedactHash
+//
+// Replaces the sensitive value with a short SHA-256 hash of it --
+// e.g. to distinguish values in logs without revealing them.
+func RedactHash(v any) Redacted {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%T:%#v", v, v)))
+	return Redacted("[sha256:" + hex.EncodeToString(sum[:8]) + "]")
+}
+
+var redactor = struct {
+	mu sync.RWMutex
+	fn RedactFunc
+}{
+	fn: RedactMask,
+}
+
+// SetRedactor
+//
+// Sets the global policy of redaction of sensitive values. Nil restores the default RedactMask.
+func SetRedactor(fn RedactFunc) {
+	redactor.mu.Lock()
+	defer redactor.mu.Unlock()
+	redactor.fn = ternary[RedactFunc](fn != nil, fn, RedactMask)
+}
+
+// redactVal
+//
+// Returns the replacement of the sensitive value according to the global policy.
+// Already redacted values are returned as is.
+func redactVal(v any) Redacted {
+	if r, ok := v.(Redacted); ok {
+		return r
+	}
+
+	redactor.mu.RLock()
+	fn := redactor.fn
+	redactor.mu.RUnlock()
+
+	return fn(v)
+}
+
+// errRedacted
+//
+// Replaces checked values in structured errors with redacted ones. Other errors are returned as is.
+func errRedacted(err error) error {
+	switch e := err.(type) {
+	case *AssertionError:
+		return e.withRedactedValue()
+	case *sentinelError:
+		return e.withErr(errRedacted(e.err))
+	case ValidationErrors:
+		es := make(ValidationErrors, 0, len(e))
+		for _, ee := range e {
+			es = append(es, errRedacted(ee))
+		}
+		return es
+	default:
+		return err
+	}
+}
@@ -0,0 +1,108 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	tAssert "github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_Sensitive(t *testing.T) {
+	const secret = "my-secret"
+
+	fnAs := func(t *testing.T, err error) *AssertionError {
+		var ae *AssertionError
+		tAssert.True(t, errors.As(err, &ae))
+		return ae
+	}
+
+	t.Run("default message", func(t *testing.T) {
+		err := Str().Sensitive().LenMin(12).Check(secret)
+		tAssert.NotContains(t, err.Error(), secret)
+		tAssert.Equal(t, "length of [redacted] expects to be greater or equal to 12, got 9", err.Error())
+
+		ae := fnAs(t, err)
+		tAssert.Equal(t, Redacted("[redacted]"), ae.Value)
+		tAssert.Equal(t, map[string]any{"min": 12}, ae.Params)
+		tAssert.NotContains(t, ae.LocalizedMsg(LocaleEn), secret)
+
+		tAssert.NotContains(t, Str().LenMin(12).Check(secret).Error(), "[redacted]")
+	})
+
+	t.Run("custom messages", func(t *testing.T) {
+		err := Str().Sensitive().LenMin(12, "{value} is too short").Check(secret)
+		tAssert.Equal(t, "[redacted] is too short", err.Error())
+
+		err = Str().Sensitive().LenMin(12).Check(secret, "{value} is incorrect")
+		tAssert.Equal(t, "[redacted] is incorrect", err.Error())
+
+		err = Str().Sensitive().Custom(func(v string) error { return errors.New("bad") }).Check(secret, "{value} is bad")
+		tAssert.Equal(t, "[redacted] is bad", err.Error())
+	})
+
+	t.Run("facts", func(t *testing.T) {
+		err := SliceAny[[]string]().
+			Sensitive().
+			CustomElementEach("short", func(e string) bool { return len(e) < 5 }).
+			Check([]string{"ok", secret})
+		tAssert.NotContains(t, err.Error(), secret)
+		tAssert.Equal(t, Redacted("[redacted]"), fnAs(t, err).Facts["elem"])
+	})
+
+	t.Run("all results", func(t *testing.T) {
+		a := Str().Named("password").Sensitive().LenMin(12).ContainsStr("!")
+
+		for _, err := range a.CheckAll(secret) {
+			tAssert.NotContains(t, err.Error(), secret)
+		}
+
+		j, jErr := json.Marshal(a.CheckAllErr(secret))
+		tAssert.NoError(t, jErr)
+		tAssert.NotContains(t, string(j), `"value":"`+secret)
+		tAssert.NotContains(t, string(j), `got \"`+secret)
+
+		tAssert.PanicsWithError(
+			t,
+			"password: length of [redacted] expects to be greater or equal to 12, got 9",
+			func() { a.Must(secret) },
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		err := Any[[]string]().Sensitive().Custom(func(v []string) error {
+			return Str().LenMin(12).Check(v[0])
+		}).Check([]string{secret})
+		tAssert.NotContains(t, err.Error(), secret)
+	})
+
+	t.Run("alternatives", func(t *testing.T) {
+		err := Str().Sensitive().AnyOf(Str().Empty(), Str().LenMin(12)).Check(secret)
+		tAssert.NotContains(t, err.Error(), secret)
+		tAssert.Contains(t, err.Error(), "(2) length of [redacted] expects to be greater or equal to 12, got 9")
+
+		j, jErr := json.Marshal(err)
+		tAssert.NoError(t, jErr)
+		tAssert.NotContains(t, string(j), secret)
+
+		err = Str().Sensitive().OneOf(Str().Empty(), Str().LenMin(12)).Check(secret)
+		tAssert.NotContains(t, err.Error(), secret)
+	})
+
+	t.Run("SetRedactor", func(t *testing.T) {
+		t.Cleanup(func() { SetRedactor(nil) })
+
+		SetRedactor(RedactHash)
+		err1 := Str().Sensitive().LenMin(12).Check(secret)
+		err2 := Str().Sensitive().LenMin(12).Check(secret + "2")
+		tAssert.NotContains(t, err1.Error(), secret)
+		tAssert.Regexp(t, `^length of \[sha256:[0-9a-f]{16}\] expects`, err1.Error())
+		tAssert.NotEqual(t, fnAs(t, err1).Value, fnAs(t, err2).Value)
+		tAssert.Equal(t, fnAs(t, err1).Value, fnAs(t, Str().Sensitive().LenMin(12).Check(secret)).Value)
+
+		SetRedactor(func(v any) Redacted { return "***" })
+		tAssert.Equal(t, "length of *** expects to be greater or equal to 12, got 9", Str().Sensitive().LenMin(12).Check(secret).Error())
+
+		SetRedactor(nil)
+		tAssert.Contains(t, Str().Sensitive().LenMin(12).Check(secret).Error(), "[redacted]")
+	})
+}
@@ -17,15 +17,20 @@ func Test_Readme(t *testing.T) {
 		type EventCollection struct{}
 
 		Deactivate := func(a *Account, deactivatedAt time.Time, evs *EventCollection) error {
-			Time().NotZero().LessEq(time.Now()).Must(deactivatedAt)
-			Cmp[*EventCollection]().NotEq(nil).Must(evs)
+			Time().Named("deactivatedAt").NotZero().LessEqFn(time.Now).Must(deactivatedAt)
+			Cmp[*EventCollection]().Named("evs").NotEq(nil).Must(evs)
 			// --
 			NotNilDeepMust(evs)
 			// ...
 			return nil
 		}
 
-		tAssert.Panics(t, func() { _ = Deactivate(&Account{}, time.Time{}, &EventCollection{}) })
+		tAssert.PanicsWithError(
+			t,
+			"deactivatedAt: value expects to be not equal to 0001-01-01T00:00:00Z, "+
+				"got 0001-01-01T00:00:00Z",
+			func() { _ = Deactivate(&Account{}, time.Time{}, &EventCollection{}) },
+		)
 		tAssert.Panics(t, func() { _ = Deactivate(&Account{}, time.Now(), nil) })
 		tAssert.Panics(t, func() {
 			var evs *EventCollection
@@ -92,6 +97,8 @@ func Test_Readme(t *testing.T) {
 
 			f.errors["email"] = Str().
 				NotEmpty("Email is required!").
+				// no need to check further, if it is empty
+				Bail().
 				Regexp(
 					emailRegexpCompiled,
 
@@ -105,18 +112,20 @@ func Test_Readme(t *testing.T) {
 				}).
 				CheckAll(f.email)
 
-			// optional field, remember?
-			if f.name != "" {
-				f.errors["name"] = Str().
-					Word("Only letters and '-' allowed!").
-					RunesMin(2, "Too short, isn't it?").
-					RunesMax(255, "Too long, isn't it?").
-					NotIn(
-						[]string{ /* e.g. some set of bad words or so */ },
-						"Is that your real name, friend?",
-					).
-					CheckAll(f.name)
-			}
+			f.errors["name"] = Str().
+				// optional field, remember?
+				UnlessPasses(
+					Str().Empty(),
+					Str().
+						Word("Only letters and '-' allowed!").
+						RunesMin(2, "Too short, isn't it?").
+						RunesMax(255, "Too long, isn't it?").
+						NotIn(
+							[]string{ /* e.g. some set of bad words or so */ },
+							"Is that your real name, friend?",
+						),
+				).
+				CheckAll(f.name)
 
 			f.errors["age"] = Num[uint]().
 				GreaterEq(18, "Things are serious -- come back later!").
@@ -142,6 +151,7 @@ func Test_Readme(t *testing.T) {
 		form.age = 17
 		form.agreement = false
 		tAssert.False(t, Validate(form))
+		tAssert.Len(t, form.errors["email"], 1)
 		tAssert.Equal(t, "Email is required!", form.errors["email"][0].Error())
 		tAssert.Empty(t, form.errors["name"])
 		tAssert.Equal(t, "Things are serious -- come back later!", form.errors["age"][0].Error())
@@ -154,6 +164,11 @@ func Test_Readme(t *testing.T) {
 		tAssert.False(t, Validate(form))
 		tAssert.Empty(t, form.errors["email"])
 		tAssert.Equal(t, "Too short, isn't it?", form.errors["name"][0].Error())
+
+		form.name = "1"
+		tAssert.False(t, Validate(form))
+		tAssert.Equal(t, "Only letters and '-' allowed!", form.errors["name"][0].Error())
+		tAssert.Equal(t, "Too short, isn't it?", form.errors["name"][1].Error())
 		tAssert.Equal(t, "Take a rest, friend!", form.errors["age"][0].Error())
 		tAssert.Empty(t, form.errors["agreement"])
 
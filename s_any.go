@@ -1,13 +1,14 @@
 package assert
 
 import (
-	"fmt"
 	"reflect"
 )
 
 type AAny[T any] struct {
 	*assert[T]
+	*mixinChain[*AAny[T], T]
 	*mixinCustom[*AAny[T], T]
+	*mixinLogic[*AAny[T], T]
 }
 
 func Any[T any]() *AAny[T] {
@@ -15,12 +16,27 @@ func Any[T any]() *AAny[T] {
 
 	*a = AAny[T]{
 		assert:      newAssert[T](),
+		mixinChain:  newMixinChain[*AAny[T], T](a),
 		mixinCustom: newMixinCustom[*AAny[T], T](a),
+		mixinLogic:  newMixinLogic[*AAny[T], T](a),
 	}
 
 	return a
 }
 
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *AAny[T]) Clone() *AAny[T] {
+	c := Any[T]()
+	c.assert = a.assert.clone()
+	return c
+}
+
 // Attention!
 //
 // It is currently technically impossible to correctly implement a non-recursive `NotNil` function
@@ -63,10 +79,13 @@ func isZeroValue(v any) bool {
 //
 // ATTENTION! THIS IS NOT THE SAME AS `v != nil` FOR INTERFACES: e.g. `interface|(*int)(nil)` fails the check.
 func (a *AAny[T]) NotZero(customErrMsg ...string) *AAny[T] {
-	a.addCheck(func(v T) error {
+	a.addRule(CodeNotZero, nil, customErrMsg, func(v T) error {
 		if isZeroValue(v) {
 			return mkCheckErr(
-				fmt.Sprintf("value expects to be non-zero, got %s", fmtVal(v)),
+				CodeNotZero,
+				nil,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -119,10 +138,13 @@ func isNilInDepth(v any) bool {
 //
 // For pointers and interfaces, the check runs recursively -- for example, for `interface|(*int)(nil)` it fails.
 func (a *AAny[T]) NotNilDeep(customErrMsg ...string) *AAny[T] {
-	a.addCheck(func(v T) error {
+	a.addRule(CodeNotNilDeep, nil, customErrMsg, func(v T) error {
 		if isNilInDepth(v) {
 			return mkCheckErr(
-				fmt.Sprintf("value expects to be non-nil in depth, got %s", fmtVal(v)),
+				CodeNotNilDeep,
+				nil,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -2,8 +2,10 @@ package assert
 
 type ABool struct {
 	*assert[bool]
+	*mixinChain[*ABool, bool]
 	*mixinComparable[*ABool, bool]
 	*mixinCustom[*ABool, bool]
+	*mixinLogic[*ABool, bool]
 }
 
 func Bool() *ABool {
@@ -11,13 +13,41 @@ func Bool() *ABool {
 
 	*a = ABool{
 		assert:          newAssert[bool](),
+		mixinChain:      newMixinChain[*ABool, bool](a),
 		mixinComparable: newMixinComparable[*ABool, bool](a),
 		mixinCustom:     newMixinCustom[*ABool, bool](a),
+		mixinLogic:      newMixinLogic[*ABool, bool](a),
 	}
 
 	return a
 }
 
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *ABool) Clone() *ABool {
+	c := Bool()
+	c.assert = a.assert.clone()
+	return c
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// JSON Schema
+// ---------------------------------------------------------------------------------------------------------------------
+
+// JSONSchema
+//
+// Returns the JSON Schema of the chain (compatible with OpenAPI 3.1) -- see Schema.
+//
+// Includes rules of the given groups (see Groups) and rules without groups or all rules, if no groups given.
+func (a *ABool) JSONSchema(groups ...string) Schema {
+	return a.jsonSchema(schemaBool, Schema{"type": "boolean"}, groups)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // True
 // ---------------------------------------------------------------------------------------------------------------------
@@ -25,6 +55,7 @@ func Bool() *ABool {
 // True -- alias to Eq(true)
 func (a *ABool) True(customErrMsg ...string) *ABool {
 	a.Eq(true, customErrMsg...)
+	a.setLastName("True")
 	return a
 }
 
@@ -35,6 +66,7 @@ func (a *ABool) True(customErrMsg ...string) *ABool {
 // False -- alias to Eq(false)
 func (a *ABool) False(customErrMsg ...string) *ABool {
 	a.Eq(false, customErrMsg...)
+	a.setLastName("False")
 	return a
 }
 
@@ -2,8 +2,10 @@ package assert
 
 type AComparable[T comparable] struct {
 	*assert[T]
+	*mixinChain[*AComparable[T], T]
 	*mixinComparable[*AComparable[T], T]
 	*mixinCustom[*AComparable[T], T]
+	*mixinLogic[*AComparable[T], T]
 }
 
 // Comparable
@@ -14,8 +16,10 @@ func Comparable[T comparable]() *AComparable[T] {
 
 	*a = AComparable[T]{
 		assert:          newAssert[T](),
+		mixinChain:      newMixinChain[*AComparable[T], T](a),
 		mixinComparable: newMixinComparable[*AComparable[T], T](a),
 		mixinCustom:     newMixinCustom[*AComparable[T], T](a),
+		mixinLogic:      newMixinLogic[*AComparable[T], T](a),
 	}
 
 	return a
@@ -24,3 +28,16 @@ func Comparable[T comparable]() *AComparable[T] {
 func Cmp[T comparable]() *AComparable[T] {
 	return Comparable[T]()
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *AComparable[T]) Clone() *AComparable[T] {
+	c := Comparable[T]()
+	c.assert = a.assert.clone()
+	return c
+}
@@ -1,5 +1,7 @@
 package assert
 
+import "reflect"
+
 type NumericTypes interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 |
 		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
@@ -8,8 +10,10 @@ type NumericTypes interface {
 
 type ANumeric[T NumericTypes] struct {
 	*assert[T]
+	*mixinChain[*ANumeric[T], T]
 	*mixinComparable[*ANumeric[T], T]
 	*mixinCustom[*ANumeric[T], T]
+	*mixinLogic[*ANumeric[T], T]
 	*mixinOrdered[*ANumeric[T], T]
 }
 
@@ -25,8 +29,10 @@ func Numeric[T NumericTypes]() *ANumeric[T] {
 
 	*a = ANumeric[T]{
 		assert:          newAssert[T](),
+		mixinChain:      newMixinChain[*ANumeric[T], T](a),
 		mixinComparable: newMixinComparable[*ANumeric[T], T](a),
 		mixinCustom:     newMixinCustom[*ANumeric[T], T](a),
+		mixinLogic:      newMixinLogic[*ANumeric[T], T](a),
 		mixinOrdered:    newMixinOrdered[*ANumeric[T], T](a, numericFnCmp[T]),
 	}
 
@@ -37,6 +43,37 @@ func Num[T NumericTypes]() *ANumeric[T] {
 	return Numeric[T]()
 }
 
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *ANumeric[T]) Clone() *ANumeric[T] {
+	c := Numeric[T]()
+	c.assert = a.assert.clone()
+	return c
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// JSON Schema
+// ---------------------------------------------------------------------------------------------------------------------
+
+// JSONSchema
+//
+// Returns the JSON Schema of the chain (compatible with OpenAPI 3.1) -- see Schema.
+//
+// Includes rules of the given groups (see Groups) and rules without groups or all rules, if no groups given.
+func (a *ANumeric[T]) JSONSchema(groups ...string) Schema {
+	var zero T
+	base := schemaOfType(reflect.TypeOf(zero))
+	if _, ok := base["minimum"]; ok {
+		base["minimum"] = zero
+	}
+	return a.jsonSchema(schemaNumber, base, groups)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // Negative
 // ---------------------------------------------------------------------------------------------------------------------
@@ -44,6 +81,7 @@ func Num[T NumericTypes]() *ANumeric[T] {
 // Negative -- alias to Less(0)
 func (a *ANumeric[T]) Negative(customErrMsg ...string) *ANumeric[T] {
 	a.Less(0, customErrMsg...)
+	a.setLastName("Negative")
 	return a
 }
 
@@ -54,6 +92,7 @@ func (a *ANumeric[T]) Negative(customErrMsg ...string) *ANumeric[T] {
 // Zero -- alias to Eq(0)
 func (a *ANumeric[T]) Zero(customErrMsg ...string) *ANumeric[T] {
 	a.Eq(0, customErrMsg...)
+	a.setLastName("Zero")
 	return a
 }
 
@@ -64,6 +103,7 @@ func (a *ANumeric[T]) Zero(customErrMsg ...string) *ANumeric[T] {
 // NotZero -- alias to NotEq(0)
 func (a *ANumeric[T]) NotZero(customErrMsg ...string) *ANumeric[T] {
 	a.NotEq(0, customErrMsg...)
+	a.setLastName("NotZero")
 	return a
 }
 
@@ -74,6 +114,7 @@ func (a *ANumeric[T]) NotZero(customErrMsg ...string) *ANumeric[T] {
 // Positive -- alias to Greater(0)
 func (a *ANumeric[T]) Positive(customErrMsg ...string) *ANumeric[T] {
 	a.Greater(0, customErrMsg...)
+	a.setLastName("Positive")
 	return a
 }
 
@@ -1,8 +1,12 @@
 package assert
 
+import "reflect"
+
 type ASliceAny[S sliceType[E], E any] struct {
 	*assert[S]
+	*mixinChain[*ASliceAny[S, E], S]
 	*mixinCustom[*ASliceAny[S, E], S]
+	*mixinLogic[*ASliceAny[S, E], S]
 	*mixinSliceAny[*ASliceAny[S, E], S, E]
 }
 
@@ -11,9 +15,41 @@ func SliceAny[S sliceType[E], E any]() *ASliceAny[S, E] {
 
 	*a = ASliceAny[S, E]{
 		assert:        newAssert[S](),
+		mixinChain:    newMixinChain[*ASliceAny[S, E], S](a),
 		mixinCustom:   newMixinCustom[*ASliceAny[S, E], S](a),
+		mixinLogic:    newMixinLogic[*ASliceAny[S, E], S](a),
 		mixinSliceAny: newMixinSliceAny[*ASliceAny[S, E], S, E](a),
 	}
 
 	return a
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *ASliceAny[S, E]) Clone() *ASliceAny[S, E] {
+	c := SliceAny[S, E]()
+	c.assert = a.assert.clone()
+	return c
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// JSON Schema
+// ---------------------------------------------------------------------------------------------------------------------
+
+// JSONSchema
+//
+// Returns the JSON Schema of the chain (compatible with OpenAPI 3.1) -- see Schema.
+//
+// Includes rules of the given groups (see Groups) and rules without groups or all rules, if no groups given.
+func (a *ASliceAny[S, E]) JSONSchema(groups ...string) Schema {
+	base := Schema{"type": "array"}
+	if items := schemaOfType(reflect.TypeOf((*E)(nil)).Elem()); items != nil {
+		base["items"] = items
+	}
+	return a.jsonSchema(schemaArray, base, groups)
+}
@@ -1,8 +1,12 @@
 package assert
 
+import "reflect"
+
 type ASliceCmp[S sliceType[E], E comparable] struct {
 	*assert[S]
+	*mixinChain[*ASliceCmp[S, E], S]
 	*mixinCustom[*ASliceCmp[S, E], S]
+	*mixinLogic[*ASliceCmp[S, E], S]
 	*mixinSliceCmp[*ASliceCmp[S, E], S, E]
 }
 
@@ -11,9 +15,41 @@ func SliceCmp[S sliceType[E], E comparable]() *ASliceCmp[S, E] {
 
 	*a = ASliceCmp[S, E]{
 		assert:        newAssert[S](),
+		mixinChain:    newMixinChain[*ASliceCmp[S, E], S](a),
 		mixinCustom:   newMixinCustom[*ASliceCmp[S, E], S](a),
+		mixinLogic:    newMixinLogic[*ASliceCmp[S, E], S](a),
 		mixinSliceCmp: newMixinSliceCmp[*ASliceCmp[S, E], S](a),
 	}
 
 	return a
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *ASliceCmp[S, E]) Clone() *ASliceCmp[S, E] {
+	c := SliceCmp[S, E]()
+	c.assert = a.assert.clone()
+	return c
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// JSON Schema
+// ---------------------------------------------------------------------------------------------------------------------
+
+// JSONSchema
+//
+// Returns the JSON Schema of the chain (compatible with OpenAPI 3.1) -- see Schema.
+//
+// Includes rules of the given groups (see Groups) and rules without groups or all rules, if no groups given.
+func (a *ASliceCmp[S, E]) JSONSchema(groups ...string) Schema {
+	base := Schema{"type": "array"}
+	if items := schemaOfType(reflect.TypeOf((*E)(nil)).Elem()); items != nil {
+		base["items"] = items
+	}
+	return a.jsonSchema(schemaArray, base, groups)
+}
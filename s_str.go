@@ -1,7 +1,6 @@
 package assert
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 	"unicode/utf8"
@@ -9,8 +8,10 @@ import (
 
 type AString struct {
 	*assert[string]
+	*mixinChain[*AString, string]
 	*mixinComparable[*AString, string]
 	*mixinCustom[*AString, string]
+	*mixinLogic[*AString, string]
 	*mixinLen[*AString, string]
 }
 
@@ -22,8 +23,10 @@ func String() *AString {
 
 	*a = AString{
 		assert:          newAssert[string](),
+		mixinChain:      newMixinChain[*AString, string](a),
 		mixinComparable: newMixinComparable[*AString, string](a),
 		mixinCustom:     newMixinCustom[*AString, string](a),
+		mixinLogic:      newMixinLogic[*AString, string](a),
 		mixinLen:        newMixinLen[*AString, string](a),
 	}
 
@@ -34,6 +37,32 @@ func Str() *AString {
 	return String()
 }
 
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *AString) Clone() *AString {
+	c := String()
+	c.assert = a.assert.clone()
+	return c
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// JSON Schema
+// ---------------------------------------------------------------------------------------------------------------------
+
+// JSONSchema
+//
+// Returns the JSON Schema of the chain (compatible with OpenAPI 3.1) -- see Schema.
+//
+// Includes rules of the given groups (see Groups) and rules without groups or all rules, if no groups given.
+func (a *AString) JSONSchema(groups ...string) Schema {
+	return a.jsonSchema(schemaString, Schema{"type": "string"}, groups)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // Empty
 // ---------------------------------------------------------------------------------------------------------------------
@@ -41,6 +70,7 @@ func Str() *AString {
 // Empty -- alias to Eq("")
 func (a *AString) Empty(customErrMsg ...string) *AString {
 	a.Eq("", customErrMsg...)
+	a.setLastName("Empty")
 	return a
 }
 
@@ -51,6 +81,7 @@ func (a *AString) Empty(customErrMsg ...string) *AString {
 // NotEmpty -- alias to NotEq(time.Time{})
 func (a *AString) NotEmpty(customErrMsg ...string) *AString {
 	a.NotEq("", customErrMsg...)
+	a.setLastName("NotEmpty")
 	return a
 }
 
@@ -69,16 +100,16 @@ func (a *AString) NotEmpty(customErrMsg ...string) *AString {
 //
 // See strings.HasPrefix.
 func (a *AString) PrefixEq(eq string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"eq": eq}
+	a.addRule(CodePrefixEq, params, customErrMsg, func(v string) error {
 		if strings.HasPrefix(v, eq) {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to have prefix equal to %s, got %s",
-				fmtVal(eq),
-				fmtVal(v),
-			),
+			CodePrefixEq,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -96,14 +127,14 @@ func (a *AString) PrefixEq(eq string, customErrMsg ...string) *AString {
 //
 // See strings.HasPrefix.
 func (a *AString) PrefixNotEq(notEq string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"notEq": notEq}
+	a.addRule(CodePrefixNotEq, params, customErrMsg, func(v string) error {
 		if strings.HasPrefix(v, notEq) {
 			return mkCheckErr(
-				fmt.Sprintf(
-					"value expects to have prefix not equal to %s, got %s",
-					fmtVal(notEq),
-					fmtVal(v),
-				),
+				CodePrefixNotEq,
+				params,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -123,7 +154,8 @@ func (a *AString) PrefixNotEq(notEq string, customErrMsg ...string) *AString {
 //
 // See strings.HasPrefix.
 func (a *AString) PrefixIn(in []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"in": in}
+	a.addRule(CodePrefixIn, params, customErrMsg, func(v string) error {
 		if len(in) > 0 {
 			for _, p := range in {
 				if strings.HasPrefix(v, p) {
@@ -132,11 +164,10 @@ func (a *AString) PrefixIn(in []string, customErrMsg ...string) *AString {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to have any of %s prefixes, got %s",
-				fmtVal(in),
-				fmtVal(v),
-			),
+			CodePrefixIn,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -154,18 +185,18 @@ func (a *AString) PrefixIn(in []string, customErrMsg ...string) *AString {
 //
 // See strings.HasPrefix.
 func (a *AString) PrefixNotIn(notIn []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"notIn": notIn}
+	a.addRule(CodePrefixNotIn, params, customErrMsg, func(v string) error {
 		if len(notIn) == 0 {
 			return nil
 		}
 		for _, p := range notIn {
 			if strings.HasPrefix(v, p) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects to have none of %s prefixes, got %s",
-						fmtVal(notIn),
-						fmtVal(v),
-					),
+					CodePrefixNotIn,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -190,16 +221,16 @@ func (a *AString) PrefixNotIn(notIn []string, customErrMsg ...string) *AString {
 //
 // See strings.HasSuffix.
 func (a *AString) SuffixEq(eq string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"eq": eq}
+	a.addRule(CodeSuffixEq, params, customErrMsg, func(v string) error {
 		if strings.HasSuffix(v, eq) {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to have suffix equal to %s, got %s",
-				fmtVal(eq),
-				fmtVal(v),
-			),
+			CodeSuffixEq,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -217,14 +248,14 @@ func (a *AString) SuffixEq(eq string, customErrMsg ...string) *AString {
 //
 // See strings.HasSuffix.
 func (a *AString) SuffixNotEq(notEq string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"notEq": notEq}
+	a.addRule(CodeSuffixNotEq, params, customErrMsg, func(v string) error {
 		if strings.HasSuffix(v, notEq) {
 			return mkCheckErr(
-				fmt.Sprintf(
-					"value expects to have suffix not equal to %s, got %s",
-					fmtVal(notEq),
-					fmtVal(v),
-				),
+				CodeSuffixNotEq,
+				params,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -244,7 +275,8 @@ func (a *AString) SuffixNotEq(notEq string, customErrMsg ...string) *AString {
 //
 // See strings.HasSuffix.
 func (a *AString) SuffixIn(in []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"in": in}
+	a.addRule(CodeSuffixIn, params, customErrMsg, func(v string) error {
 		if len(in) > 0 {
 			for _, s := range in {
 				if strings.HasSuffix(v, s) {
@@ -253,11 +285,10 @@ func (a *AString) SuffixIn(in []string, customErrMsg ...string) *AString {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to have any of %s suffixes, got %s",
-				fmtVal(in),
-				fmtVal(v),
-			),
+			CodeSuffixIn,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -275,18 +306,18 @@ func (a *AString) SuffixIn(in []string, customErrMsg ...string) *AString {
 //
 // See strings.HasSuffix.
 func (a *AString) SuffixNotIn(notIn []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"notIn": notIn}
+	a.addRule(CodeSuffixNotIn, params, customErrMsg, func(v string) error {
 		if len(notIn) == 0 {
 			return nil
 		}
 		for _, s := range notIn {
 			if strings.HasSuffix(v, s) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects to have none of %s suffixes, got %s",
-						fmtVal(notIn),
-						fmtVal(v),
-					),
+					CodeSuffixNotIn,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -311,16 +342,16 @@ func (a *AString) SuffixNotIn(notIn []string, customErrMsg ...string) *AString {
 //
 // See strings.Contains.
 func (a *AString) ContainsStr(s string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"substr": s}
+	a.addRule(CodeContainsStr, params, customErrMsg, func(v string) error {
 		if strings.Contains(v, s) {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to contain %s substring, got %s",
-				fmtVal(s),
-				fmtVal(v),
-			),
+			CodeContainsStr,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -338,14 +369,14 @@ func (a *AString) ContainsStr(s string, customErrMsg ...string) *AString {
 //
 // See strings.Contains.
 func (a *AString) NotContainsStr(s string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"substr": s}
+	a.addRule(CodeNotContainsStr, params, customErrMsg, func(v string) error {
 		if strings.Contains(v, s) {
 			return mkCheckErr(
-				fmt.Sprintf(
-					"value expects to not contain %s substring, got %s",
-					fmtVal(s),
-					fmtVal(v),
-				),
+				CodeNotContainsStr,
+				params,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -365,7 +396,8 @@ func (a *AString) NotContainsStr(s string, customErrMsg ...string) *AString {
 //
 // See strings.Contains.
 func (a *AString) ContainsStrAny(ss []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"substrs": ss}
+	a.addRule(CodeContainsStrAny, params, customErrMsg, func(v string) error {
 		if len(ss) == 0 {
 			return nil
 		}
@@ -375,11 +407,10 @@ func (a *AString) ContainsStrAny(ss []string, customErrMsg ...string) *AString {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"value expects to contain any of %s substrings, got %s",
-				fmtVal(ss),
-				fmtVal(v),
-			),
+			CodeContainsStrAny,
+			params,
+			v,
+			nil,
 			customErrMsg,
 		)
 	})
@@ -397,18 +428,18 @@ func (a *AString) ContainsStrAny(ss []string, customErrMsg ...string) *AString {
 //
 // See strings.Contains.
 func (a *AString) ContainsStrEach(ss []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"substrs": ss}
+	a.addRule(CodeContainsStrEach, params, customErrMsg, func(v string) error {
 		if len(ss) == 0 {
 			return nil
 		}
 		for _, s := range ss {
 			if !strings.Contains(v, s) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects to contain each of %s substrings, got %s",
-						fmtVal(ss),
-						fmtVal(v),
-					),
+					CodeContainsStrEach,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -429,18 +460,18 @@ func (a *AString) ContainsStrEach(ss []string, customErrMsg ...string) *AString
 //
 // See strings.Contains.
 func (a *AString) ContainsStrNone(ss []string, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"substrs": ss}
+	a.addRule(CodeContainsStrNone, params, customErrMsg, func(v string) error {
 		if len(ss) == 0 {
 			return nil
 		}
 		for _, s := range ss {
 			if strings.Contains(v, s) {
 				return mkCheckErr(
-					fmt.Sprintf(
-						"value expects to contain none of %s substrings, got %s",
-						fmtVal(ss),
-						fmtVal(v),
-					),
+					CodeContainsStrNone,
+					params,
+					v,
+					nil,
 					customErrMsg,
 				)
 			}
@@ -463,18 +494,17 @@ func (a *AString) ContainsStrNone(ss []string, customErrMsg ...string) *AString
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (a *AString) RunesEq(eq int, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"eq": eq}
+	a.addRule(CodeRunesEq, params, customErrMsg, func(v string) error {
 		l := utf8.RuneCountInString(v)
 		if newMixinComparable[*assert[int], int](newAssert[int]()).Eq(eq).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"runes count of %s expects to be equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(eq),
-				fmtVal(l),
-			),
+			CodeRunesEq,
+			params,
+			v,
+			map[string]any{"runes": l},
 			customErrMsg,
 		)
 	})
@@ -490,18 +520,17 @@ func (a *AString) RunesEq(eq int, customErrMsg ...string) *AString {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (a *AString) RunesNotEq(notEq int, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"notEq": notEq}
+	a.addRule(CodeRunesNotEq, params, customErrMsg, func(v string) error {
 		l := utf8.RuneCountInString(v)
 		if newMixinComparable[*assert[int], int](newAssert[int]()).NotEq(notEq).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"runes count of %s expects to be not equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(notEq),
-				fmtVal(l),
-			),
+			CodeRunesNotEq,
+			params,
+			v,
+			map[string]any{"runes": l},
 			customErrMsg,
 		)
 	})
@@ -523,18 +552,17 @@ func (a *AString) runesOrdCmp(b, s int) bool {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (a *AString) RunesMin(min int, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"min": min}
+	a.addRule(CodeRunesMin, params, customErrMsg, func(v string) error {
 		l := utf8.RuneCountInString(v)
 		if newMixinOrdered[*assert[int], int](newAssert[int](), a.runesOrdCmp).GreaterEq(min).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"runes count of %s expects to be greater or equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(l),
-			),
+			CodeRunesMin,
+			params,
+			v,
+			map[string]any{"runes": l},
 			customErrMsg,
 		)
 	})
@@ -550,18 +578,17 @@ func (a *AString) RunesMin(min int, customErrMsg ...string) *AString {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (a *AString) RunesMax(max int, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"max": max}
+	a.addRule(CodeRunesMax, params, customErrMsg, func(v string) error {
 		l := utf8.RuneCountInString(v)
 		if newMixinOrdered[*assert[int], int](newAssert[int](), a.runesOrdCmp).LessEq(max).Check(l) == nil {
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"runes count of %s expects to be less or equal to %s, got %s",
-				fmtVal(v),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeRunesMax,
+			params,
+			v,
+			map[string]any{"runes": l},
 			customErrMsg,
 		)
 	})
@@ -579,7 +606,8 @@ func (a *AString) RunesMax(max int, customErrMsg ...string) *AString {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (a *AString) RunesInRange(min, max int, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"min": min, "max": max}
+	a.addRule(CodeRunesInRange, params, customErrMsg, func(v string) error {
 		l := utf8.RuneCountInString(v)
 		if min <= max {
 			if newMixinOrdered[*assert[int], int](newAssert[int](), a.runesOrdCmp).InRange(min, max).Check(l) == nil {
@@ -587,13 +615,10 @@ func (a *AString) RunesInRange(min, max int, customErrMsg ...string) *AString {
 			}
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"runes count of %s expects to be in range [%s, %s], got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeRunesInRange,
+			params,
+			v,
+			map[string]any{"runes": l},
 			customErrMsg,
 		)
 	})
@@ -611,7 +636,8 @@ func (a *AString) RunesInRange(min, max int, customErrMsg ...string) *AString {
 //
 // Logically incorrect params (e.g. negative values, etc.) are processed as usual.
 func (a *AString) RunesNotInRange(min, max int, customErrMsg ...string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"min": min, "max": max}
+	a.addRule(CodeRunesNotInRange, params, customErrMsg, func(v string) error {
 		if min > max {
 			return nil
 		}
@@ -620,13 +646,10 @@ func (a *AString) RunesNotInRange(min, max int, customErrMsg ...string) *AString
 			return nil
 		}
 		return mkCheckErr(
-			fmt.Sprintf(
-				"runes count of %s expects to be not in range [%s, %s], got %s",
-				fmtVal(v),
-				fmtVal(min),
-				fmtVal(max),
-				fmtVal(l),
-			),
+			CodeRunesNotInRange,
+			params,
+			v,
+			map[string]any{"runes": l},
 			customErrMsg,
 		)
 	})
@@ -638,10 +661,14 @@ func (a *AString) RunesNotInRange(min, max int, customErrMsg ...string) *AString
 // ---------------------------------------------------------------------------------------------------------------------
 
 func (a *AString) regexp(r *regexp.Regexp, customErrMsg []string) *AString {
-	a.addCheck(func(v string) error {
+	params := map[string]any{"pattern": r.String()}
+	a.addRule(CodeRegexp, params, customErrMsg, func(v string) error {
 		if !r.MatchString(v) {
 			return mkCheckErr(
-				fmt.Sprintf("value expects to be matched to regexp %s, got %s", fmtVal(r), fmtVal(v)),
+				CodeRegexp,
+				params,
+				v,
+				nil,
 				customErrMsg,
 			)
 		}
@@ -667,7 +694,9 @@ var StringRegexpWord = regexp.MustCompile("^[A-Za-z](-?[A-Za-z]+)*$")
 //
 // See StringRegexpWord
 func (a *AString) Word(customErrMsg ...string) *AString {
-	return a.regexp(StringRegexpWord, customErrMsg)
+	a.regexp(StringRegexpWord, customErrMsg)
+	a.setLastName("Word")
+	return a
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
@@ -683,7 +712,9 @@ var StringRegexpNumeric = regexp.MustCompile("^-?\\d+(\\.\\d+)?$")
 //
 // See StringRegexpNumeric
 func (a *AString) Numeric(customErrMsg ...string) *AString {
-	return a.regexp(StringRegexpNumeric, customErrMsg)
+	a.regexp(StringRegexpNumeric, customErrMsg)
+	a.setLastName("Numeric")
+	return a
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
@@ -505,3 +505,23 @@ func Test_AString_Regexp(t *testing.T) {
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
+// Messages
+// ---------------------------------------------------------------------------------------------------------------------
+
+func Test_AString_Messages(t *testing.T) {
+	t.Run("templates", func(t *testing.T) {
+		a := Str().RunesMax(5, "Name must be at most {max} characters, you entered {runes}")
+		tAssert.Equal(t, "Name must be at most 5 characters, you entered 6", a.Check("Привет").Error())
+
+		a = Str().Word("{value} is not a word (see {pattern})")
+		tAssert.Equal(t, `"1" is not a word (see "^[A-Za-z](-?[A-Za-z]+)*$")`, a.Check("1").Error())
+		tAssert.Equal(t, `Name "1" is incorrect!`, a.Check("1", "Name {value} is incorrect!").Error())
+	})
+
+	t.Run("MsgFn", func(t *testing.T) {
+		a := Str().NotEmpty().MsgFn(func(v string) string { return "required" })
+		tAssert.Equal(t, "required", a.Check("").Error())
+	})
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
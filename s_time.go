@@ -6,8 +6,10 @@ import (
 
 type ATime struct {
 	*assert[time.Time]
+	*mixinChain[*ATime, time.Time]
 	*mixinComparable[*ATime, time.Time]
 	*mixinCustom[*ATime, time.Time]
+	*mixinLogic[*ATime, time.Time]
 	*mixinOrdered[*ATime, time.Time]
 }
 
@@ -20,14 +22,42 @@ func Time() *ATime {
 
 	*a = ATime{
 		assert:          newAssert[time.Time](),
+		mixinChain:      newMixinChain[*ATime, time.Time](a),
 		mixinComparable: newMixinComparable[*ATime, time.Time](a),
 		mixinCustom:     newMixinCustom[*ATime, time.Time](a),
+		mixinLogic:      newMixinLogic[*ATime, time.Time](a),
 		mixinOrdered:    newMixinOrdered[*ATime, time.Time](a, timeFnCmp),
 	}
 
 	return a
 }
 
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *ATime) Clone() *ATime {
+	c := Time()
+	c.assert = a.assert.clone()
+	return c
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+// JSON Schema
+// ---------------------------------------------------------------------------------------------------------------------
+
+// JSONSchema
+//
+// Returns the JSON Schema of the chain (compatible with OpenAPI 3.1) -- see Schema.
+//
+// Includes rules of the given groups (see Groups) and rules without groups or all rules, if no groups given.
+func (a *ATime) JSONSchema(groups ...string) Schema {
+	return a.jsonSchema(schemaTime, Schema{"type": "string", "format": "date-time"}, groups)
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // Zero
 // ---------------------------------------------------------------------------------------------------------------------
@@ -35,6 +65,7 @@ func Time() *ATime {
 // Zero -- alias to Eq(time.Time{})
 func (a *ATime) Zero(customErrMsg ...string) *ATime {
 	a.Eq(time.Time{}, customErrMsg...)
+	a.setLastName("Zero")
 	return a
 }
 
@@ -45,6 +76,7 @@ func (a *ATime) Zero(customErrMsg ...string) *ATime {
 // NotZero -- alias to NotEq(time.Time{})
 func (a *ATime) NotZero(customErrMsg ...string) *ATime {
 	a.NotEq(time.Time{}, customErrMsg...)
+	a.setLastName("NotZero")
 	return a
 }
 
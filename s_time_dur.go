@@ -6,8 +6,10 @@ import (
 
 type ATimeDuration struct {
 	*assert[time.Duration]
+	*mixinChain[*ATimeDuration, time.Duration]
 	*mixinComparable[*ATimeDuration, time.Duration]
 	*mixinCustom[*ATimeDuration, time.Duration]
+	*mixinLogic[*ATimeDuration, time.Duration]
 	*mixinOrdered[*ATimeDuration, time.Duration]
 }
 
@@ -23,7 +25,10 @@ func TimeDuration() *ATimeDuration {
 
 	*a = ATimeDuration{
 		assert:          newAssert[time.Duration](),
+		mixinChain:      newMixinChain[*ATimeDuration, time.Duration](a),
 		mixinComparable: newMixinComparable[*ATimeDuration, time.Duration](a),
+		mixinCustom:     newMixinCustom[*ATimeDuration, time.Duration](a),
+		mixinLogic:      newMixinLogic[*ATimeDuration, time.Duration](a),
 		mixinOrdered:    newMixinOrdered[*ATimeDuration, time.Duration](a, timeDurationFnCmp),
 	}
 
@@ -34,6 +39,19 @@ func TimeDur() *ATimeDuration {
 	return TimeDuration()
 }
 
+// ---------------------------------------------------------------------------------------------------------------------
+// Clone
+// ---------------------------------------------------------------------------------------------------------------------
+
+// Clone
+//
+// Returns the independent copy of the chain -- e.g. to extend a shared chain without changing it.
+func (a *ATimeDuration) Clone() *ATimeDuration {
+	c := TimeDuration()
+	c.assert = a.assert.clone()
+	return c
+}
+
 // ---------------------------------------------------------------------------------------------------------------------
 // Zero
 // ---------------------------------------------------------------------------------------------------------------------
@@ -41,6 +59,7 @@ func TimeDur() *ATimeDuration {
 // Zero -- alias to Eq(time.Duration(0))
 func (a *ATimeDuration) Zero(customErrMsg ...string) *ATimeDuration {
 	a.Eq(time.Duration(0), customErrMsg...)
+	a.setLastName("Zero")
 	return a
 }
 
@@ -51,6 +70,7 @@ func (a *ATimeDuration) Zero(customErrMsg ...string) *ATimeDuration {
 // NotZero -- alias to NotEq(time.Duration(0))
 func (a *ATimeDuration) NotZero(customErrMsg ...string) *ATimeDuration {
 	a.NotEq(time.Duration(0), customErrMsg...)
+	a.setLastName("NotZero")
 	return a
 }
 